@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/gemini"
+	utils "github.com/chhand2808/goleet/internal/util"
+	"github.com/spf13/cobra"
+)
+
+// aiCmd groups commands about the AI integration itself.
+var aiCmd = &cobra.Command{
+	Use:   "ai",
+	Short: "Inspect GoLeet's use of the Gemini API",
+}
+
+func init() {
+	rootCmd.AddCommand(aiCmd)
+}
+
+// newAIClient builds a Gemini client that logs its retries and records
+// token usage for command in data/usage.json.
+func newAIClient(store *data.Store, command string) (*gemini.Client, error) {
+	client, err := gemini.NewClient(store)
+	if err != nil {
+		return nil, err
+	}
+	client.OnRetry = func(attempt int, wait time.Duration, err error) {
+		utils.Info("Gemini unavailable (%v); retry %d in %s", err, attempt, wait.Round(time.Millisecond))
+	}
+	client.OnUsage = func(u gemini.Usage) {
+		utils.Debug("Gemini usage: %d prompt + %d response tokens (~$%.5f)", u.PromptTokens, u.ResponseTokens, u.CostUSD)
+		err := store.AppendUsage(data.AIUsage{
+			At:             time.Now(),
+			Command:        command,
+			Model:          u.Model,
+			PromptTokens:   u.PromptTokens,
+			ResponseTokens: u.ResponseTokens,
+			CostUSD:        u.CostUSD,
+		})
+		if err != nil {
+			utils.Warn("Failed to record AI usage: %v", err)
+		}
+	}
+	return client, nil
+}
+
+// interruptContext is cancelled on Ctrl-C.
+func interruptContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt)
+}
+
+// withSpinner runs fn while the "thinking" spinner is shown (production mode only).
+func withSpinner(fn func() error) error {
+	var stop chan bool
+	if utils.IsProduction && !utils.DebugEnabled {
+		stop = utils.StartSpinner()
+	}
+	err := fn()
+	utils.StopSpinner(stop)
+	return err
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/spf13/cobra"
+)
+
+var aiUsageCmd = &cobra.Command{
+	Use:   "usage",
+	Short: "Show AI token usage and estimated cost by day and command",
+	Run: func(cmd *cobra.Command, args []string) {
+		store := data.NewStore()
+
+		usage, err := store.LoadUsage()
+		if err != nil {
+			fmt.Println("❌ Failed to load usage:", err)
+			return
+		}
+
+		days, _ := cmd.Flags().GetInt("days")
+		now := time.Now()
+		since := now.AddDate(0, 0, -days+1).Format("2006-01-02")
+
+		byDay := map[string]*usageTotal{}
+		byCommand := map[string]*usageTotal{}
+		for _, u := range usage {
+			day := u.At.In(now.Location()).Format("2006-01-02")
+			if day < since {
+				continue
+			}
+			addUsage(byDay, day, u)
+			addUsage(byCommand, u.Command, u)
+		}
+
+		if len(byDay) == 0 {
+			fmt.Printf("📭 No AI calls in the last %d days.\n", days)
+		} else {
+			fmt.Printf("📊 AI usage, last %d days:\n", days)
+			fmt.Printf("%-12s %6s %10s %10s %10s\n", "Day", "Calls", "Prompt", "Response", "Cost")
+			for _, day := range sortedKeys(byDay) {
+				printUsageRow(day, byDay[day])
+			}
+
+			fmt.Println()
+			fmt.Printf("%-12s %6s %10s %10s %10s\n", "Command", "Calls", "Prompt", "Response", "Cost")
+			for _, c := range sortedKeys(byCommand) {
+				printUsageRow(c, byCommand[c])
+			}
+		}
+
+		cfg, err := store.LoadConfig()
+		if err != nil {
+			fmt.Println("❌ Failed to load config:", err)
+			return
+		}
+		spent := data.MonthCost(usage, now)
+		fmt.Println()
+		if cfg.MonthlyBudgetUSD > 0 {
+			fmt.Printf("💰 This month: $%.4f of $%.2f budget\n", spent, cfg.MonthlyBudgetUSD)
+			if spent >= cfg.MonthlyBudgetUSD {
+				fmt.Println("⚠️ Budget reached: AI commands use offline fallbacks until next month.")
+			}
+		} else {
+			fmt.Printf("💰 This month: $%.4f (no monthly_budget_usd set)\n", spent)
+		}
+	},
+}
+
+func init() {
+	aiCmd.AddCommand(aiUsageCmd)
+
+	aiUsageCmd.Flags().Int("days", 30, "How many days back to report")
+}
+
+type usageTotal struct {
+	Calls          int
+	PromptTokens   int
+	ResponseTokens int
+	CostUSD        float64
+}
+
+func addUsage(totals map[string]*usageTotal, key string, u data.AIUsage) {
+	t, ok := totals[key]
+	if !ok {
+		t = &usageTotal{}
+		totals[key] = t
+	}
+	t.Calls++
+	t.PromptTokens += u.PromptTokens
+	t.ResponseTokens += u.ResponseTokens
+	t.CostUSD += u.CostUSD
+}
+
+func sortedKeys(totals map[string]*usageTotal) []string {
+	keys := make([]string, 0, len(totals))
+	for k := range totals {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func printUsageRow(label string, t *usageTotal) {
+	fmt.Printf("%-12s %6d %10d %10d %10s\n", label, t.Calls, t.PromptTokens, t.ResponseTokens, fmt.Sprintf("$%.4f", t.CostUSD))
+}
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/gemini"
+	utils "github.com/chhand2808/goleet/internal/util"
+	"github.com/spf13/cobra"
+)
+
+// chatResumeShown is how many earlier messages are reprinted on resume.
+const chatResumeShown = 4
+
+var chatCmd = &cobra.Command{
+	Use:   "chat [questionID]",
+	Short: "Talk a problem through with an AI tutor",
+	Long: `Opens a conversation with an AI tutor that knows the problem and your notes
+(data/notes/<id>/notes.md). It guides you with questions and hints and only
+gives the full solution if you explicitly ask for it.
+
+The transcript is kept in data/notes/<id>/chat.json, so running the command
+again resumes the conversation. Type /quit (or Ctrl-D) to leave.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		store := data.NewStore()
+		catalog, err := store.LoadCatalog()
+		if err != nil {
+			fmt.Println("❌ Failed to load problems:", err)
+			return
+		}
+
+		problem, ok := catalog.ByID(args[0])
+		if !ok {
+			fmt.Println("⚠️ Problem ID not found:", args[0])
+			return
+		}
+
+		notes, err := store.LoadNotes(problem.ID)
+		if err != nil {
+			utils.Warn("Failed to load notes: %v", err)
+		}
+
+		msgs, err := store.LoadChat(problem.ID)
+		if err != nil {
+			fmt.Println("❌ Failed to load transcript:", err)
+			return
+		}
+		if fresh, _ := cmd.Flags().GetBool("new"); fresh {
+			msgs = []data.ChatMessage{}
+		}
+
+		client, err := newAIClient(store, "chat")
+		if err != nil {
+			fmt.Println("❌ Cannot use Gemini:", err)
+			return
+		}
+		system := gemini.BuildChatSystem(problem, notes)
+
+		fmt.Printf("🎓 Tutor for %s. %s (%s)\n", problem.ID, problem.Title, problem.Difficulty)
+		if len(msgs) > 0 {
+			fmt.Printf("↩️ Resuming conversation (%d messages)\n", len(msgs))
+			start := max(0, len(msgs)-chatResumeShown)
+			for _, m := range msgs[start:] {
+				printChatMessage(m)
+			}
+		}
+
+		// -m sends a single message without the interactive loop
+		if message, _ := cmd.Flags().GetString("message"); message != "" {
+			chatTurn(store, client, problem.ID, system, &msgs, message)
+			return
+		}
+
+		fmt.Println("Type /quit to leave.")
+		in := bufio.NewScanner(os.Stdin)
+		for {
+			fmt.Print("\n🧑 ")
+			if !in.Scan() {
+				fmt.Println()
+				return
+			}
+			line := strings.TrimSpace(in.Text())
+			switch line {
+			case "":
+				continue
+			case "/quit", "/exit":
+				return
+			}
+			if !chatTurn(store, client, problem.ID, system, &msgs, line) {
+				return
+			}
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(chatCmd)
+
+	chatCmd.Flags().Bool("new", false, "Start a new conversation instead of resuming")
+	chatCmd.Flags().StringP("message", "m", "", "Send one message and exit")
+}
+
+// chatTurn sends one user message, prints and saves the reply. It returns
+// false when the conversation should end (Ctrl-C).
+func chatTurn(store *data.Store, client *gemini.Client, id, system string, msgs *[]data.ChatMessage, text string) bool {
+	pending := append(*msgs, data.ChatMessage{Role: data.ChatUser, Text: text, At: time.Now()})
+
+	ctx, stop := interruptContext()
+	defer stop()
+
+	var reply string
+	err := withSpinner(func() error {
+		var err error
+		reply, err = client.Chat(ctx, system, pending)
+		return err
+	})
+	if errors.Is(err, context.Canceled) {
+		fmt.Println("🛑 Cancelled.")
+		return false
+	}
+	if err != nil {
+		// the unanswered message is not kept, so it can simply be sent again
+		fmt.Println("❌ Tutor unavailable:", err)
+		return true
+	}
+
+	answer := data.ChatMessage{Role: data.ChatModel, Text: strings.TrimSpace(reply), At: time.Now()}
+	*msgs = append(pending, answer)
+	printChatMessage(answer)
+
+	if err := store.SaveChat(id, *msgs); err != nil {
+		fmt.Println("⚠️ Failed to save transcript:", err)
+	}
+	return true
+}
+
+func printChatMessage(m data.ChatMessage) {
+	if m.Role == data.ChatUser {
+		fmt.Println("🧑", m.Text)
+		return
+	}
+	fmt.Println("🎓", m.Text)
+}
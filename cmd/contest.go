@@ -0,0 +1,439 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/recommend"
+	"github.com/chhand2808/goleet/internal/runner"
+	"github.com/chhand2808/goleet/internal/scaffold"
+	"github.com/spf13/cobra"
+)
+
+// contestSlots is the problem set of a weekly contest with its points.
+var contestSlots = []struct {
+	Difficulty string
+	Points     float64
+}{
+	{"Easy", 3}, {"Medium", 4}, {"Medium", 5}, {"Hard", 6},
+}
+
+// Contest rating: Elo against the problem set, where the expected share of
+// the points comes from each problem's rating and the actual share is cut
+// by up to contestSpeedWeight for a late finish.
+const (
+	contestInitialRating = 1500.0
+	contestK             = 80.0
+	contestSpeedWeight   = 0.2
+	contestWrongPenalty  = 5 * time.Minute // per failed submission, as on LeetCode
+)
+
+var contestCmd = &cobra.Command{
+	Use:   "contest",
+	Short: "Run a simulated weekly contest (Easy, Medium, Medium, Hard)",
+	Long: fmt.Sprintf(`Builds a 4-problem set of unsolved problems and runs a timed contest scored
+like LeetCode weekly contests: points per solved problem, ranked by finish
+time plus %d minutes per wrong submission before the solve.
+
+During the contest:
+  test N     run the tests of problem N's workspace (a red run counts as wrong)
+  ac N       mark problem N accepted without running tests
+  wrong N    record a wrong submission on problem N
+  status     show the board
+  quit       end the contest early (so does Ctrl-C)
+
+Test runs stop at the end of the contest or on Ctrl-C.
+
+Each contest with at least one submission updates your contest rating
+(start %.0f); see --history.`,
+		int(contestWrongPenalty.Minutes()), contestInitialRating),
+	Run: func(cmd *cobra.Command, args []string) {
+		store := data.NewStore()
+		if list, _ := cmd.Flags().GetBool("history"); list {
+			showContestHistory(store)
+			return
+		}
+
+		duration, _ := cmd.Flags().GetDuration("duration")
+		dir, _ := cmd.Flags().GetString("dir")
+		freeOnly, _ := cmd.Flags().GetBool("free-only")
+		if duration <= 0 {
+			fmt.Println("⚠️ --duration must be positive.")
+			return
+		}
+
+		catalog, err := store.LoadCatalog()
+		if err != nil {
+			fmt.Println("❌ Failed to load problems:", err)
+			return
+		}
+		sessions, err := store.LoadSessions()
+		if err != nil {
+			fmt.Println("❌ Failed to load sessions:", err)
+			return
+		}
+
+		warnUnsupportedFilters(catalog, data.Filter{FreeOnly: freeOnly})
+		problems, err := pickContestSet(store, catalog, freeOnly)
+		if err != nil {
+			fmt.Println("⚠️", err)
+			return
+		}
+
+		session := runContest(store, problems, duration, dir)
+		if !contestSubmitted(session) {
+			fmt.Println("\n📭 No submissions: the contest is not rated or recorded.")
+			return
+		}
+		session.Rating, session.RatingChange = rateContest(contestRating(sessions), problems, session)
+		printContestReport(session)
+
+		if err := store.AppendSession(session); err != nil {
+			fmt.Println("⚠️ Failed to save session:", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(contestCmd)
+
+	contestCmd.Flags().Duration("duration", 90*time.Minute, "Contest length")
+	contestCmd.Flags().String("dir", scaffold.DefaultDir, "Directory where workspaces are created")
+	contestCmd.Flags().Bool("free-only", false, "Exclude paid-only problems")
+	contestCmd.Flags().Bool("history", false, "Show your contest rating history instead of starting one")
+}
+
+// pickContestSet draws each slot from the best few unsolved problems of its
+// difficulty, so contests vary but stay near the user's level.
+func pickContestSet(store *data.Store, catalog *data.Catalog, freeOnly bool) ([]data.Problem, error) {
+	solved, _ := store.LoadSolved()
+	history, _ := store.LoadHistory()
+	attempts, _ := store.LoadAttempts()
+	skills := recommend.RateSkills(catalog, solved, attempts, time.Now())
+
+	taken := map[string]bool{}
+	set := []data.Problem{}
+	for _, slot := range contestSlots {
+		filter := data.Filter{Difficulty: slot.Difficulty, FreeOnly: freeOnly}
+		pool := []data.Problem{}
+		for _, p := range recommend.Local(catalog, solved, history, skills, filter, len(contestSlots)+5) {
+			if !taken[p.ID] {
+				pool = append(pool, p)
+			}
+		}
+		if len(pool) == 0 {
+			return nil, fmt.Errorf("no unsolved %s problem left for the contest", slot.Difficulty)
+		}
+		p := pool[rand.IntN(min(len(pool), 5))]
+		taken[p.ID] = true
+		set = append(set, p)
+	}
+	return set, nil
+}
+
+func runContest(store *data.Store, problems []data.Problem, duration time.Duration, dir string) data.Session {
+	start := time.Now()
+	deadline := start.Add(duration)
+
+	session := data.Session{
+		Kind:        data.SessionContest,
+		Start:       start,
+		DurationSec: int64(duration.Seconds()),
+	}
+	for i, p := range problems {
+		session.Problems = append(session.Problems, data.SessionProblem{ID: p.ID, Title: p.Title, Difficulty: p.Difficulty})
+		session.MaxScore += contestSlots[i].Points
+	}
+
+	fmt.Printf("🏆 Virtual contest: %d problems, %s\n", len(problems), formatClock(duration))
+	printContestBoard(session, problems)
+	fmt.Println(`Commands: test N, ac N, wrong N, status, quit. Scaffold with: goleet scaffold <id>`)
+
+	lines := readLines()
+	timer := newCountdown(deadline)
+	defer timer.Stop()
+
+	// Ctrl-C ends the contest like quit, so what was done so far is kept
+	ctx, stop := interruptContext()
+	defer stop()
+
+	accept := func(i int) {
+		sp := &session.Problems[i]
+		sp.Outcome = data.OutcomeSolved
+		sp.Seconds = int64(time.Since(start).Round(time.Second).Seconds())
+		sp.Points = contestSlots[i].Points
+		session.Score += sp.Points
+		if err := store.MarkSolved(problems[i].ID, problems[i].Title); err != nil {
+			fmt.Println("⚠️ Failed to mark as solved:", err)
+		}
+		fmt.Printf("✅ Q%d accepted at %s (+%.0f)\n", i+1, formatClock(time.Since(start)), sp.Points)
+	}
+
+	for session.Solved() < len(problems) {
+		fmt.Printf("⏱ %s left > ", formatClock(time.Until(deadline)))
+
+		var line string
+		select {
+		case l, ok := <-lines:
+			if !ok {
+				return finishContest(session, start, duration)
+			}
+			line = l
+		case <-timer.Done:
+			fmt.Println("\n⏰ Time's up!")
+			return finishContest(session, start, duration)
+		case <-ctx.Done():
+			fmt.Println("\n🛑 Interrupted: ending the contest.")
+			return finishContest(session, start, duration)
+		}
+
+		fields := strings.Fields(strings.ToLower(line))
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "status", "s":
+			printContestBoard(session, problems)
+			continue
+		case "quit", "q":
+			return finishContest(session, start, duration)
+		case "test", "t", "ac", "wrong", "w":
+		default:
+			fmt.Println("   Commands: test N, ac N, wrong N, status, quit")
+			continue
+		}
+
+		i, ok := contestProblem(fields, len(problems))
+		if !ok {
+			fmt.Printf("   Which problem? e.g. %s 2\n", fields[0])
+			continue
+		}
+		if session.Problems[i].Outcome == data.OutcomeSolved {
+			fmt.Printf("   Q%d is already accepted.\n", i+1)
+			continue
+		}
+
+		switch fields[0] {
+		case "ac":
+			accept(i)
+			recordContestAttempt(store, problems[i].ID, true)
+		case "wrong", "w":
+			session.Problems[i].Wrong++
+			recordContestAttempt(store, problems[i].ID, false)
+			fmt.Printf("❌ Q%d wrong submission (%d so far)\n", i+1, session.Problems[i].Wrong)
+		default:
+			passed, ran := contestTest(ctx, deadline, dir, problems[i])
+			if !ran {
+				continue // nothing was submitted
+			}
+			recordContestAttempt(store, problems[i].ID, passed)
+			if passed {
+				accept(i)
+			} else {
+				session.Problems[i].Wrong++
+				fmt.Printf("❌ Q%d wrong submission (%d so far)\n", i+1, session.Problems[i].Wrong)
+			}
+		}
+	}
+
+	fmt.Println("🎉 All problems accepted!")
+	return finishContest(session, start, duration)
+}
+
+// contestProblem parses the problem number of a "test N"-style command.
+func contestProblem(fields []string, n int) (int, bool) {
+	if len(fields) < 2 {
+		return 0, false
+	}
+	i, err := strconv.Atoi(strings.TrimPrefix(fields[1], "q"))
+	if err != nil || i < 1 || i > n {
+		return 0, false
+	}
+	return i - 1, true
+}
+
+// contestTest runs the workspace tests of p until the contest ends or is
+// interrupted. ran is false when no tests could be run, which does not
+// count as a submission.
+func contestTest(ctx context.Context, deadline time.Time, dir string, p data.Problem) (passed, ran bool) {
+	workspace, ok := scaffold.FindWorkspace(dir, p.ID)
+	if !ok {
+		fmt.Printf("⚠️ No workspace for %s. Run: goleet scaffold %s\n", p.ID, p.ID)
+		return false, false
+	}
+	lang, err := runner.DetectLang(workspace)
+	if err != nil {
+		fmt.Println("❌", err)
+		return false, false
+	}
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	res, err := runner.Run(ctx, workspace, lang)
+	if ctx.Err() != nil {
+		fmt.Println("⏹ Test run stopped.")
+		return false, false
+	}
+	if err != nil {
+		fmt.Println("❌ Test run failed:", err)
+		return false, false
+	}
+	printTestResult(res)
+	if res.Ran() == 0 {
+		return false, false
+	}
+	return res.Passed(), true
+}
+
+func recordContestAttempt(store *data.Store, id string, passed bool) {
+	err := store.AppendAttempt(data.Attempt{
+		ID:     id,
+		At:     time.Now(),
+		Source: "contest",
+		Passed: passed,
+		Hints:  store.HintLevel(id),
+	})
+	if err != nil {
+		fmt.Println("⚠️ Failed to record attempt:", err)
+	}
+}
+
+// finishContest settles unsolved problems and computes the finish time:
+// the last accept plus the penalty for wrong submissions on solved problems.
+func finishContest(session data.Session, start time.Time, duration time.Duration) data.Session {
+	session.ElapsedSec = int64(min(time.Since(start), duration).Seconds())
+
+	var last int64
+	wrong := 0
+	for i := range session.Problems {
+		sp := &session.Problems[i]
+		if sp.Outcome != data.OutcomeSolved {
+			sp.Outcome = data.OutcomeUnfinished
+			continue
+		}
+		last = max(last, sp.Seconds)
+		wrong += sp.Wrong
+	}
+	session.PenaltySec = last + int64(wrong)*int64(contestWrongPenalty.Seconds())
+	return session
+}
+
+// contestSubmitted reports whether any problem got an ac, wrong or test
+// submission, i.e. whether the contest was actually taken part in.
+func contestSubmitted(s data.Session) bool {
+	for _, sp := range s.Problems {
+		if sp.Outcome == data.OutcomeSolved || sp.Wrong > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// contestRating is the rating after the latest contest.
+func contestRating(sessions []data.Session) float64 {
+	rating := contestInitialRating
+	for _, s := range sessions {
+		if s.Kind == data.SessionContest {
+			rating = s.Rating
+		}
+	}
+	return rating
+}
+
+// rateContest returns the new rating and the change from rating.
+func rateContest(rating float64, problems []data.Problem, s data.Session) (float64, float64) {
+	if s.MaxScore == 0 {
+		return rating, 0
+	}
+
+	expected := 0.0
+	for i, p := range problems {
+		chance := 1 / (1 + math.Pow(10, (recommend.ProblemRating(p)-rating)/400))
+		expected += contestSlots[i].Points * chance
+	}
+	// an average finish is assumed to land halfway through
+	expected = expected / s.MaxScore * (1 - contestSpeedWeight/2)
+
+	late := math.Min(1, float64(s.PenaltySec)/float64(s.DurationSec))
+	actual := s.Score / s.MaxScore * (1 - contestSpeedWeight*late)
+
+	change := math.Round(contestK * (actual - expected))
+	return rating + change, change
+}
+
+func printContestBoard(s data.Session, problems []data.Problem) {
+	for i, sp := range s.Problems {
+		state := ""
+		switch {
+		case sp.Outcome == data.OutcomeSolved:
+			state = "✅ " + formatClock(time.Duration(sp.Seconds)*time.Second)
+		case sp.Outcome == data.OutcomeUnfinished:
+			state = "⏰"
+		}
+		if sp.Wrong > 0 {
+			state += fmt.Sprintf(" (%d wrong)", sp.Wrong)
+		}
+		line := fmt.Sprintf("   Q%d [%.0f] %-50s %s", i+1, contestSlots[i].Points,
+			fmt.Sprintf("%s. %s (%s)", problems[i].ID, problems[i].Title, problems[i].Difficulty), strings.TrimSpace(state))
+		fmt.Println(strings.TrimRight(line, " "))
+	}
+}
+
+func printContestReport(s data.Session) {
+	fmt.Println()
+	fmt.Println("📋 Contest report")
+	for i, sp := range s.Problems {
+		line := fmt.Sprintf("   Q%d %s. %s (%s)", i+1, sp.ID, sp.Title, sp.Difficulty)
+		if sp.Outcome == data.OutcomeSolved {
+			line += fmt.Sprintf(" — ✅ %s, +%.0f", formatClock(time.Duration(sp.Seconds)*time.Second), sp.Points)
+		} else {
+			line += " — ⬜ not solved"
+		}
+		if sp.Wrong > 0 {
+			line += fmt.Sprintf(", %d wrong", sp.Wrong)
+		}
+		fmt.Println(line)
+	}
+	fmt.Printf("\n🏁 Score %.0f / %.0f, finish time %s (incl. penalties)\n",
+		s.Score, s.MaxScore, formatClock(time.Duration(s.PenaltySec)*time.Second))
+	fmt.Printf("📈 Contest rating %.0f (%+.0f)\n", s.Rating, s.RatingChange)
+}
+
+func showContestHistory(store *data.Store) {
+	sessions, err := store.LoadSessions()
+	if err != nil {
+		fmt.Println("❌ Failed to load sessions:", err)
+		return
+	}
+
+	contests := []data.Session{}
+	for _, s := range sessions {
+		if s.Kind == data.SessionContest {
+			contests = append(contests, s)
+		}
+	}
+	if len(contests) == 0 {
+		fmt.Println("📭 No contests yet. Start one with: goleet contest")
+		return
+	}
+
+	best := contests[0].Rating
+	fmt.Printf("🏆 Contest history (%d):\n", len(contests))
+	fmt.Printf("%-17s %7s %8s %10s %7s\n", "Started", "Score", "Solved", "Finish", "Rating")
+	for _, s := range contests {
+		best = max(best, s.Rating)
+		fmt.Printf("%-17s %7s %8s %10s %7.0f %+.0f\n",
+			s.Start.Local().Format("2006-01-02 15:04"),
+			fmt.Sprintf("%.0f/%.0f", s.Score, s.MaxScore),
+			fmt.Sprintf("%d/%d", s.Solved(), len(s.Problems)),
+			formatClock(time.Duration(s.PenaltySec)*time.Second),
+			s.Rating, s.RatingChange)
+	}
+	fmt.Printf("\n📈 Current rating %.0f, best %.0f\n", contests[len(contests)-1].Rating, best)
+}
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/recommend"
+	"github.com/spf13/cobra"
+)
+
+// defaultTeam seeds the daily problem when no team is set.
+const defaultTeam = "goleet"
+
+var dailyCmd = &cobra.Command{
+	Use:   "daily",
+	Short: "Show the team's problem of the day",
+	Long: `Picks the problem of the day from the team name and the date, so everyone
+on the team gets the same problem without coordinating. The difficulty
+follows a daily rotation.
+
+data/team.json can set the team name, a shared problem list, the rotation
+and each member's data directory, e.g.
+
+  {
+    "name": "backend",
+    "rotation": ["Easy", "Medium", "Hard"],
+    "members": [{"name": "alice", "dir": "team/alice/data"}]
+  }
+
+With members configured, shows who has solved today's problem. Without a
+shared list everyone needs the same catalog (goleet init) to agree.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		store := data.NewStore()
+		catalog, err := store.LoadCatalog()
+		if err != nil {
+			fmt.Println("❌ Failed to load problems:", err)
+			return
+		}
+		team, err := store.LoadTeam()
+		if err != nil {
+			fmt.Println("❌ Failed to load team:", err)
+			return
+		}
+
+		if name, _ := cmd.Flags().GetString("team"); name != "" {
+			team.Name = name
+		}
+		if team.Name == "" {
+			team.Name = defaultTeam
+		}
+
+		day := time.Now()
+		if date, _ := cmd.Flags().GetString("date"); date != "" {
+			day, err = time.ParseInLocation("2006-01-02", date, time.Local)
+			if err != nil {
+				fmt.Println("⚠️ Invalid --date, expected YYYY-MM-DD:", date)
+				return
+			}
+		}
+		today := day.Format("2006-01-02")
+
+		problem, ok := recommend.Daily(catalog, team.Problems, team.Rotation, strings.ToLower(team.Name), day)
+		if !ok {
+			fmt.Println("⚠️ No problems to choose from: check the team's problem list.")
+			return
+		}
+
+		fmt.Printf("📅 Problem of the day for %s, %s:\n", team.Name, today)
+		fmt.Printf("   %s. %s (%s)\n", problem.ID, problem.Title, problem.Difficulty)
+		fmt.Println("   Topics:", strings.Join(problem.Topics(), ", "))
+		fmt.Println("  ", problem.Link())
+
+		solved, _ := store.LoadSolved()
+		if date, ok := solvedDate(solved, problem.ID); ok {
+			fmt.Printf("\n✅ You solved it on %s\n", date)
+		} else {
+			fmt.Printf("\n⬜ Not solved yet. Start with: goleet scaffold %s\n", problem.ID)
+		}
+
+		if len(team.Members) == 0 {
+			return
+		}
+		fmt.Println()
+		fmt.Println("👥 Team:")
+		done := 0
+		for _, m := range team.Members {
+			memberSolved, found, err := data.LoadMemberSolved(m.Dir)
+			switch {
+			case err != nil:
+				fmt.Printf("   ⚠️ %-16s %v\n", m.Name, err)
+			case !found:
+				fmt.Printf("   ⚠️ %-16s no solved.json in %s\n", m.Name, m.Dir)
+			default:
+				if date, ok := solvedDate(memberSolved, problem.ID); ok {
+					done++
+					fmt.Printf("   ✅ %-16s solved %s\n", m.Name, date)
+				} else {
+					fmt.Printf("   ⬜ %-16s not yet\n", m.Name)
+				}
+			}
+		}
+		fmt.Printf("\n%d/%d members solved it\n", done, len(team.Members))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(dailyCmd)
+
+	dailyCmd.Flags().String("team", "", "Team name to seed the pick with (default from data/team.json)")
+	dailyCmd.Flags().String("date", "", "Show the problem of another day (YYYY-MM-DD)")
+}
+
+// solvedDate returns when id was solved.
+func solvedDate(solved []data.SolvedProblem, id string) (string, bool) {
+	for _, s := range solved {
+		if s.ID == id {
+			return s.Date, true
+		}
+	}
+	return "", false
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/recommend"
+	"github.com/chhand2808/goleet/internal/similar"
 
 	"github.com/spf13/cobra"
 )
@@ -17,39 +19,49 @@ var doneCmd = &cobra.Command{
 		store := data.NewStore()
 
 		// Load all problems
-		problems, err := store.LoadProblems()
+		catalog, err := store.LoadCatalog()
 		if err != nil {
 			fmt.Println("❌ Failed to load problems:", err)
 			return
 		}
 
 		// Find problem by ID
-		var title string
-		found := false
-		for _, p := range problems {
-			if p.ID == questionID {
-				title = p.Title
-				found = true
-				break
-			}
-		}
-
+		problem, found := catalog.ByID(questionID)
 		if !found {
 			fmt.Println("⚠️ Problem ID not found:", questionID)
 			return
 		}
 
-		// Mark as solved
-		err = store.MarkSolved(questionID, title)
-		if err != nil {
-			fmt.Println("❌ Failed to mark as solved:", err)
-			return
-		}
-
-		fmt.Printf("✅ Marked as solved: %s (%s)\n", title, questionID)
+		markDone(store, catalog, problem)
 	},
 }
 
+// nextStepCount is how many follow-up problems are shown after a solve.
+const nextStepCount = 3
+
+// markDone records problem as solved, reports the outcome and points at
+// related problems to try next.
+func markDone(store *data.Store, catalog *data.Catalog, problem data.Problem) {
+	err := store.MarkSolved(problem.ID, problem.Title)
+	if err != nil {
+		fmt.Println("❌ Failed to mark as solved:", err)
+		return
+	}
+
+	fmt.Printf("✅ Marked as solved: %s (%s)\n", problem.Title, problem.ID)
+
+	solved, _ := store.LoadSolved()
+	history, _ := store.LoadHistory()
+	next := recommend.NextSteps(catalog, solved, history, similar.ForCatalog(catalog), problem, nextStepCount)
+	if len(next) == 0 {
+		return
+	}
+	fmt.Println("➡️ Next steps:")
+	for _, p := range next {
+		fmt.Printf("   %s. %s (%s)\n", p.ID, p.Title, p.Difficulty)
+	}
+}
+
 func init() {
 	rootCmd.AddCommand(doneCmd)
 }
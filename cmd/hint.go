@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+	utils "github.com/chhand2808/goleet/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var hintCmd = &cobra.Command{
+	Use:   "hint [questionID]",
+	Short: "Reveal the next hint for a problem (1 nudge, 2 approach, 3 sketch)",
+	Long: `Asks the AI for a progressively stronger hint without spoiling the solution.
+Each call reveals the next level; revealed hints are remembered per problem,
+and solving after a hint is recorded as "solved with hints" in your stats.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		level, _ := cmd.Flags().GetInt("level")
+		refresh, _ := cmd.Flags().GetBool("refresh")
+
+		store := data.NewStore()
+		catalog, err := store.LoadCatalog()
+		if err != nil {
+			fmt.Println("❌ Failed to load problems:", err)
+			return
+		}
+
+		problem, ok := catalog.ByID(args[0])
+		if !ok {
+			fmt.Println("⚠️ Problem ID not found:", args[0])
+			return
+		}
+
+		used, err := store.HintsFor(problem.ID)
+		if err != nil {
+			fmt.Println("❌ Failed to load hints:", err)
+			return
+		}
+
+		if level == 0 {
+			level = nextHintLevel(used)
+			if level > data.MaxHintLevel {
+				fmt.Println("🔓 All hints revealed for", problem.Title)
+				printHints(used)
+				return
+			}
+		}
+		if level < 1 || level > data.MaxHintLevel {
+			fmt.Printf("⚠️ Hint level must be 1-%d\n", data.MaxHintLevel)
+			return
+		}
+
+		if h, ok := used[level]; ok && !refresh {
+			printHint(h)
+			return
+		}
+
+		text, err := fetchHint(store, problem, level, used)
+		if errors.Is(err, context.Canceled) {
+			fmt.Println("🛑 Cancelled.")
+			return
+		}
+		if err != nil {
+			fmt.Println("❌ Could not get a hint:", err)
+			return
+		}
+
+		rec := data.HintRecord{
+			ID:    problem.ID,
+			Level: level,
+			Text:  text,
+			Date:  time.Now().Format("2006-01-02"),
+		}
+		if err := store.SaveHint(rec); err != nil {
+			utils.Warn("Failed to save hint: %v", err)
+		}
+		printHint(rec)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(hintCmd)
+
+	hintCmd.Flags().Int("level", 0, "Hint level 1-3 (default: next unrevealed level)")
+	hintCmd.Flags().Bool("refresh", false, "Ask again even if this level was already revealed")
+}
+
+// fetchHint asks the AI; without it, it falls back to the catalog's own hints.
+func fetchHint(store *data.Store, problem data.Problem, level int, used map[int]data.HintRecord) (string, error) {
+	client, err := newAIClient(store, "hint")
+	if err == nil {
+		ctx, stop := interruptContext()
+		defer stop()
+
+		var text string
+		err = withSpinner(func() error {
+			var err error
+			text, err = client.GetHint(ctx, problem, level, used)
+			return err
+		})
+		if err == nil || errors.Is(err, context.Canceled) {
+			return text, err
+		}
+	}
+
+	if level <= len(problem.Hints) {
+		utils.Warn("AI unavailable (%v); using the catalog hint", err)
+		return problem.Hints[level-1], nil
+	}
+	return "", err
+}
+
+func nextHintLevel(used map[int]data.HintRecord) int {
+	level := 1
+	for used[level].Text != "" {
+		level++
+	}
+	return level
+}
+
+func printHint(h data.HintRecord) {
+	fmt.Printf("💡 Hint %d/%d (%s):\n%s\n", h.Level, data.MaxHintLevel, data.HintLevelName(h.Level), h.Text)
+}
+
+func printHints(used map[int]data.HintRecord) {
+	levels := make([]int, 0, len(used))
+	for l := range used {
+		levels = append(levels, l)
+	}
+	sort.Ints(levels)
+	for _, l := range levels {
+		fmt.Println()
+		printHint(used[l])
+	}
+}
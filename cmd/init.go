@@ -4,10 +4,10 @@ import (
 	"bufio"
 	"fmt"
 	"os"
-	"path/filepath"
 	"strings"
 
-	"github.com/chhand2808/goleet/data"
+	assets "github.com/chhand2808/goleet/data"
+	"github.com/chhand2808/goleet/internal/data"
 	"github.com/spf13/cobra"
 )
 
@@ -26,7 +26,8 @@ var initCmd = &cobra.Command{
 }
 
 func InitConfig() error {
-	configDir := "data"
+	configDir := data.DataDir
+	store := data.NewStore()
 
 	// Create data directory if missing
 	if _, err := os.Stat(configDir); os.IsNotExist(err) {
@@ -42,35 +43,33 @@ func InitConfig() error {
 	apiKey, _ := reader.ReadString('\n')
 	apiKey = strings.TrimSpace(apiKey)
 
-	// Save config.json
-	configPath := filepath.Join(configDir, "config.json")
-	err := os.WriteFile(configPath, []byte(fmt.Sprintf(`{"api_key": "%s"}`, apiKey)), 0644)
+	// Save config.json, keeping any other settings already there
+	cfg, _ := store.LoadConfig()
+	cfg.APIKey = apiKey
+	err := store.SaveConfig(cfg)
 	if err != nil {
 		return err
 	}
 
 	// ✅ Write embedded problems.json (only if not exists)
-	problemsPath := filepath.Join(configDir, "problems.json")
-	if _, err := os.Stat(problemsPath); os.IsNotExist(err) {
-		err = os.WriteFile(problemsPath, data.EmbeddedProblems, 0644)
+	if _, err := os.Stat(store.ProblemsPath); os.IsNotExist(err) {
+		err = os.WriteFile(store.ProblemsPath, assets.EmbeddedProblems, 0644)
 		if err != nil {
 			return err
 		}
 	}
 
 	// Create solved.json if missing
-	solvedPath := filepath.Join(configDir, "solved.json")
-	if _, err := os.Stat(solvedPath); os.IsNotExist(err) {
-		err = os.WriteFile(solvedPath, []byte("[]"), 0644)
+	if _, err := os.Stat(store.SolvedPath); os.IsNotExist(err) {
+		err = os.WriteFile(store.SolvedPath, []byte("[]"), 0644)
 		if err != nil {
 			return err
 		}
 	}
 
 	// Create history.json if missing
-	historyPath := filepath.Join(configDir, "history.json")
-	if _, err := os.Stat(historyPath); os.IsNotExist(err) {
-		err = os.WriteFile(historyPath, []byte("[]"), 0644)
+	if _, err := os.Stat(store.HistoryPath); os.IsNotExist(err) {
+		err = os.WriteFile(store.HistoryPath, []byte("[]"), 0644)
 		if err != nil {
 			return err
 		}
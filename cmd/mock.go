@@ -0,0 +1,322 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/recommend"
+	"github.com/spf13/cobra"
+)
+
+// Mock scoring: base points per difficulty, plus up to mockTimeBonus of
+// them for finishing well inside the problem's share of the time.
+var mockPoints = map[string]float64{"Easy": 3, "Medium": 5, "Hard": 8}
+
+const (
+	mockTimeBonus   = 0.5
+	mockHintPenalty = 0.2 // share of the points lost per hint level revealed during the session
+)
+
+var mockCmd = &cobra.Command{
+	Use:   "mock",
+	Short: "Run a timed mock interview on unsolved problems",
+	Long: `Picks unsolved problems and starts a countdown. Work on each problem in turn
+and type "done" when it is solved or "give-up" to move on; "quit" or Ctrl-C
+ends the session early. Solves count like goleet done, and a scored report is kept in
+data/sessions.json, separate from casual practice.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if list, _ := cmd.Flags().GetBool("history"); list {
+			showMockHistory()
+			return
+		}
+
+		duration, _ := cmd.Flags().GetDuration("duration")
+		count, _ := cmd.Flags().GetInt("count")
+		if duration <= 0 || count <= 0 {
+			fmt.Println("⚠️ --duration and --count must be positive.")
+			return
+		}
+
+		store := data.NewStore()
+		catalog, err := store.LoadCatalog()
+		if err != nil {
+			fmt.Println("❌ Failed to load problems:", err)
+			return
+		}
+		solved, _ := store.LoadSolved()
+		history, _ := store.LoadHistory()
+		attempts, _ := store.LoadAttempts()
+		skills := recommend.RateSkills(catalog, solved, attempts, time.Now())
+
+		// shuffle the best few so repeated mocks don't always get the same set
+		filter := filterFromFlags(cmd)
+		warnUnsupportedFilters(catalog, filter)
+		pool := recommend.Local(catalog, solved, history, skills, filter, count*3)
+		if len(pool) < count {
+			fmt.Printf("⚠️ Only %d unsolved problems match; need %d.\n", len(pool), count)
+			return
+		}
+		rand.Shuffle(len(pool), func(i, j int) { pool[i], pool[j] = pool[j], pool[i] })
+
+		session := runMock(store, pool[:count], duration)
+		printMockReport(session)
+
+		if err := store.AppendSession(session); err != nil {
+			fmt.Println("⚠️ Failed to save session:", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(mockCmd)
+
+	mockCmd.Flags().Duration("duration", 45*time.Minute, "Time for the whole session")
+	mockCmd.Flags().Int("count", 2, "Number of problems")
+	mockCmd.Flags().String("difficulty", "", "Only pick problems of this difficulty (Easy, Medium, Hard)")
+	mockCmd.Flags().String("topic", "", "Only pick problems with this topic tag")
+	mockCmd.Flags().Bool("free-only", false, "Exclude paid-only problems")
+	mockCmd.Flags().Float64("min-acceptance", 0, "Minimum acceptance rate in percent")
+	mockCmd.Flags().Bool("history", false, "List past mock sessions instead of starting one")
+}
+
+// runMock walks through the problems until they are all settled, time runs
+// out or the user quits.
+func runMock(store *data.Store, problems []data.Problem, duration time.Duration) data.Session {
+	start := time.Now()
+	deadline := start.Add(duration)
+	slot := duration / time.Duration(len(problems))
+
+	session := data.Session{
+		Kind:        data.SessionMock,
+		Start:       start,
+		DurationSec: int64(duration.Seconds()),
+	}
+	for _, p := range problems {
+		session.Problems = append(session.Problems, data.SessionProblem{ID: p.ID, Title: p.Title, Difficulty: p.Difficulty})
+		session.MaxScore += mockPoints[p.Difficulty] * (1 + mockTimeBonus)
+	}
+
+	fmt.Printf("🎤 Mock interview: %d problems in %s\n", len(problems), formatClock(duration))
+	fmt.Println(`Type "done" when solved, "give-up" to move on, "quit" to stop.`)
+
+	lines := readLines()
+	timer := newCountdown(deadline)
+	defer timer.Stop()
+
+	// Ctrl-C ends the session like quit, so it is still scored and saved
+	ctx, stop := interruptContext()
+	defer stop()
+
+	for i, p := range problems {
+		sp := &session.Problems[i]
+		hintsBefore := store.HintLevel(p.ID)
+		begun := time.Now()
+
+		fmt.Printf("\n📘 %d/%d  %s. %s (%s)\n", i+1, len(problems), p.ID, p.Title, p.Difficulty)
+		fmt.Println("   ", p.Link())
+		fmt.Printf("   Scaffold it with: goleet scaffold %s\n", p.ID)
+
+		outcome := ""
+		for outcome == "" {
+			fmt.Printf("⏱ %s left > ", formatClock(time.Until(deadline)))
+			select {
+			case line, ok := <-lines:
+				if !ok {
+					outcome = data.OutcomeUnfinished // stdin closed
+					break
+				}
+				switch strings.ToLower(strings.TrimSpace(line)) {
+				case "done", "d":
+					outcome = data.OutcomeSolved
+				case "give-up", "giveup", "g":
+					outcome = data.OutcomeGaveUp
+				case "quit", "q":
+					outcome = data.OutcomeUnfinished
+				case "":
+				default:
+					fmt.Println(`   Commands: done, give-up, quit`)
+				}
+			case <-timer.Done:
+				fmt.Println("\n⏰ Time's up!")
+				outcome = data.OutcomeUnfinished
+			case <-ctx.Done():
+				fmt.Println("\n🛑 Interrupted: ending the session.")
+				outcome = data.OutcomeUnfinished
+			}
+		}
+
+		took := time.Since(begun)
+		sp.Outcome = outcome
+		sp.Seconds = int64(took.Round(time.Second).Seconds())
+		sp.Hints = max(0, store.HintLevel(p.ID)-hintsBefore)
+
+		attempt := data.Attempt{
+			ID:     p.ID,
+			At:     time.Now(),
+			Source: "mock",
+			Passed: outcome == data.OutcomeSolved,
+			Hints:  store.HintLevel(p.ID),
+		}
+		if attempt.Passed {
+			attempt.SolveSeconds = sp.Seconds
+			sp.Points = mockScore(p.Difficulty, took, slot, sp.Hints)
+			session.Score += sp.Points
+			if err := store.MarkSolved(p.ID, p.Title); err != nil {
+				fmt.Println("⚠️ Failed to mark as solved:", err)
+			}
+			fmt.Printf("✅ Solved in %s (+%.1f)\n", formatClock(took), sp.Points)
+		}
+		if err := store.AppendAttempt(attempt); err != nil {
+			fmt.Println("⚠️ Failed to record attempt:", err)
+		}
+
+		if outcome == data.OutcomeUnfinished {
+			break // time's up or quit: the rest stays unreached
+		}
+	}
+
+	session.ElapsedSec = int64(min(time.Since(start), duration).Seconds())
+	return session
+}
+
+// mockScore gives full points plus a bonus shrinking linearly to nothing
+// as the solve uses up the problem's slot; revealed hints cost points.
+func mockScore(difficulty string, took, slot time.Duration, hints int) float64 {
+	base := mockPoints[difficulty]
+	bonus := mockTimeBonus * math.Max(0, 1-took.Seconds()/slot.Seconds())
+	points := base * (1 + bonus) * math.Max(0, 1-mockHintPenalty*float64(hints))
+	return math.Round(points*10) / 10
+}
+
+func printMockReport(s data.Session) {
+	fmt.Println()
+	fmt.Println("📋 Mock report")
+	for _, p := range s.Problems {
+		icon, what := "⬜", "not reached"
+		switch p.Outcome {
+		case data.OutcomeSolved:
+			icon, what = "✅", "solved"
+		case data.OutcomeGaveUp:
+			icon, what = "🏳️", "gave up"
+		case data.OutcomeUnfinished:
+			icon, what = "⏰", "unfinished"
+		}
+		line := fmt.Sprintf("   %s %s. %s (%s) — %s", icon, p.ID, p.Title, p.Difficulty, what)
+		if p.Outcome != "" {
+			line += " in " + formatClock(time.Duration(p.Seconds)*time.Second)
+		}
+		if p.Hints > 0 {
+			line += fmt.Sprintf(", %d hint levels", p.Hints)
+		}
+		fmt.Printf("%s  %.1f pts\n", line, p.Points)
+	}
+	fmt.Printf("\n🏁 Score %.1f / %.1f (%.0f%%), %d/%d solved, %s used of %s\n",
+		s.Score, s.MaxScore, percent(s.Score, s.MaxScore), s.Solved(), len(s.Problems),
+		formatClock(time.Duration(s.ElapsedSec)*time.Second), formatClock(time.Duration(s.DurationSec)*time.Second))
+}
+
+func showMockHistory() {
+	sessions, err := data.NewStore().LoadSessions()
+	if err != nil {
+		fmt.Println("❌ Failed to load sessions:", err)
+		return
+	}
+
+	mocks := []data.Session{}
+	for _, s := range sessions {
+		if s.Kind == data.SessionMock {
+			mocks = append(mocks, s)
+		}
+	}
+	if len(mocks) == 0 {
+		fmt.Println("📭 No mock sessions yet. Start one with: goleet mock")
+		return
+	}
+
+	fmt.Printf("🎤 Mock sessions (%d):\n", len(mocks))
+	fmt.Printf("%-17s %8s %8s %14s %6s\n", "Started", "Solved", "Time", "Score", "%")
+	total := 0.0
+	for _, s := range mocks {
+		total += percent(s.Score, s.MaxScore)
+		fmt.Printf("%-17s %8s %8s %14s %5.0f%%\n",
+			s.Start.Local().Format("2006-01-02 15:04"),
+			fmt.Sprintf("%d/%d", s.Solved(), len(s.Problems)),
+			formatClock(time.Duration(s.ElapsedSec)*time.Second),
+			fmt.Sprintf("%.1f/%.1f", s.Score, s.MaxScore),
+			percent(s.Score, s.MaxScore))
+	}
+	fmt.Printf("\nAverage: %.0f%%\n", total/float64(len(mocks)))
+}
+
+func percent(part, whole float64) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return 100 * part / whole
+}
+
+// readLines feeds stdin lines into a channel, closed at EOF, so input can
+// be waited for alongside the timer.
+func readLines() <-chan string {
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		in := bufio.NewScanner(os.Stdin)
+		for in.Scan() {
+			lines <- in.Text()
+		}
+	}()
+	return lines
+}
+
+// countdownAlerts are the remaining times announced while a session runs.
+var countdownAlerts = []time.Duration{30 * time.Minute, 15 * time.Minute, 10 * time.Minute, 5 * time.Minute, time.Minute}
+
+// countdown announces the remaining time at countdownAlerts and closes
+// Done at the deadline.
+type countdown struct {
+	Done chan struct{}
+	stop chan struct{}
+}
+
+func newCountdown(deadline time.Time) *countdown {
+	c := &countdown{Done: make(chan struct{}), stop: make(chan struct{})}
+	go func() {
+		defer close(c.Done)
+		alerts := []time.Duration{}
+		for _, a := range countdownAlerts {
+			if a < time.Until(deadline) {
+				alerts = append(alerts, a)
+			}
+		}
+		for _, a := range append(alerts, 0) {
+			select {
+			case <-time.After(time.Until(deadline.Add(-a))):
+				if a > 0 {
+					fmt.Printf("\n⏳ %s left\n> ", formatClock(a))
+				}
+			case <-c.stop:
+				return
+			}
+		}
+	}()
+	return c
+}
+
+func (c *countdown) Stop() { close(c.stop) }
+
+// formatClock renders d as m:ss (h:mm:ss from an hour up).
+func formatClock(d time.Duration) string {
+	d = max(0, d.Round(time.Second))
+	h, m, s := int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("%d:%02d", m, s)
+}
@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/recommend"
+	"github.com/spf13/cobra"
+)
+
+var pathCmd = &cobra.Command{
+	Use:   "path [topic]",
+	Short: "Show your learning path through topics and what unlocks next",
+	Long: fmt.Sprintf(`Topics build on each other (e.g. Array → Two Pointers → Sliding Window).
+A topic counts as covered after %d solves; once all its prerequisites are
+covered it is ready. With a topic argument, shows the chain leading to it.`, recommend.CoverSolves),
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		all, _ := cmd.Flags().GetBool("all")
+
+		store := data.NewStore()
+		catalog, err := store.LoadCatalog()
+		if err != nil {
+			fmt.Println("❌ Failed to load problems:", err)
+			return
+		}
+		solved, _ := store.LoadSolved()
+		history, _ := store.LoadHistory()
+		attempts, _ := store.LoadAttempts()
+		skills := recommend.RateSkills(catalog, solved, attempts, time.Now())
+
+		curriculum := recommend.Curriculum(catalog, solved)
+		byTopic := map[string]recommend.TopicStatus{}
+		for _, st := range curriculum {
+			byTopic[st.Topic] = st
+		}
+
+		// next problem to practice a topic with
+		pick := func(topic string) string {
+			picks := recommend.Local(catalog, solved, history, skills, data.Filter{Topic: topic}, 1)
+			if len(picks) == 0 {
+				return ""
+			}
+			return fmt.Sprintf("%s. %s (%s)", picks[0].ID, picks[0].Title, picks[0].Difficulty)
+		}
+
+		if len(args) == 1 {
+			topic, ok := recommend.CanonicalTopic(catalog, args[0])
+			if !ok {
+				fmt.Println("⚠️ Unknown topic:", args[0])
+				return
+			}
+			fmt.Printf("🧭 Path to %s:\n", topic)
+			step := 0
+			for _, t := range recommend.Chain(topic) {
+				st, ok := byTopic[t]
+				if !ok {
+					continue // not in this catalog
+				}
+				step++
+				line := fmt.Sprintf("%d) %s %s %d/%d", step, stateIcon(st.State), t, min(st.Solved, st.Needed), st.Needed)
+				if st.State == recommend.TopicReady {
+					if p := pick(t); p != "" {
+						line += "  👉 " + p
+					}
+				}
+				fmt.Println(line)
+			}
+			return
+		}
+
+		covered := []string{}
+		ready := []recommend.TopicStatus{}
+		locked := []recommend.TopicStatus{}
+		hidden := 0
+		for _, st := range curriculum {
+			switch st.State {
+			case recommend.TopicCovered:
+				covered = append(covered, fmt.Sprintf("%s (%d)", st.Topic, st.Solved))
+			case recommend.TopicReady:
+				// dead ends (nothing builds on them) only with --all
+				if all || len(st.Unlocks) > 0 {
+					ready = append(ready, st)
+				} else {
+					hidden++
+				}
+			default:
+				// by default only show topics one step away
+				if all || oneStepAway(st, byTopic) {
+					locked = append(locked, st)
+				} else {
+					hidden++
+				}
+			}
+		}
+
+		fmt.Printf("🧭 Learning path (a topic is covered after %d solves)\n\n", recommend.CoverSolves)
+		if len(covered) > 0 {
+			fmt.Println("✅ Covered:", strings.Join(covered, ", "))
+			fmt.Println()
+		}
+
+		if len(ready) > 0 {
+			fmt.Println("🔓 Ready:")
+			for _, st := range ready {
+				line := fmt.Sprintf("   %-26s %d/%d", st.Topic, st.Solved, st.Needed)
+				if len(st.Unlocks) > 0 {
+					line += "  → unlocks " + strings.Join(st.Unlocks, ", ")
+				}
+				fmt.Println(line)
+			}
+			fmt.Println()
+		}
+
+		if len(locked) > 0 {
+			fmt.Println("🔒 Locked:")
+			for _, st := range locked {
+				fmt.Printf("   %-26s needs %s\n", st.Topic, strings.Join(st.Missing, ", "))
+			}
+			fmt.Println()
+		}
+
+		if hidden > 0 {
+			fmt.Printf("(%d more topics with --all)\n\n", hidden)
+		}
+
+		// closest to covered wins; ties go to the topic that unlocks more
+		var next *recommend.TopicStatus
+		for i, st := range ready {
+			if len(st.Unlocks) == 0 {
+				continue
+			}
+			if next == nil || st.Needed-st.Solved < next.Needed-next.Solved ||
+				(st.Needed-st.Solved == next.Needed-next.Solved && len(st.Unlocks) > len(next.Unlocks)) {
+				next = &ready[i]
+			}
+		}
+		if next != nil {
+			if p := pick(next.Topic); p != "" {
+				fmt.Printf("👉 Next: %s — try %s\n", next.Topic, p)
+			}
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(pathCmd)
+
+	pathCmd.Flags().Bool("all", false, "Also list dead-end and far-away locked topics")
+}
+
+// oneStepAway reports whether every missing prerequisite of st is ready.
+func oneStepAway(st recommend.TopicStatus, byTopic map[string]recommend.TopicStatus) bool {
+	for _, m := range st.Missing {
+		if byTopic[m].State != recommend.TopicReady {
+			return false
+		}
+	}
+	return true
+}
+
+func stateIcon(state string) string {
+	switch state {
+	case recommend.TopicCovered:
+		return "✅"
+	case recommend.TopicReady:
+		return "🔓"
+	}
+	return "🔒"
+}
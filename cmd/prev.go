@@ -62,7 +62,22 @@ func showPrev(n int) {
 		idx := total - 1 - i // latest at end -> show it first
 		entry := hist[idx]
 		dateStr := formatRelativeDate(entry.Date)
-		fmt.Printf("%d. %s (%s)\n", i+1, entry.Title, dateStr)
+		label := ""
+		switch {
+		case entry.IsOffered():
+			label = " [offered]"
+		case entry.Status == data.StatusSkipped && entry.SkipReason != "":
+			label = " [skipped: " + entry.SkipReason + "]"
+		case entry.Status == data.StatusSkipped:
+			label = " [skipped]"
+		}
+		if entry.IsSnoozed(time.Now().Format("2006-01-02")) {
+			label += " [snoozed until " + entry.SnoozedUntil + "]"
+		}
+		fmt.Printf("%d. %s (%s)%s\n", i+1, entry.Title, dateStr, label)
+		if entry.Reason != "" {
+			fmt.Printf("   💡 %s\n", entry.Reason)
+		}
 	}
 }
 
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/gemini"
+	"github.com/chhand2808/goleet/internal/recommend"
+	"github.com/spf13/cobra"
+)
+
+var promptCmd = &cobra.Command{
+	Use:   "prompt",
+	Short: "Work with the AI prompt templates",
+	Long: `The suggestion prompt is a Go text/template. Override the built-in one by
+saving your own as data/templates/prompts/suggest.tmpl; start from
+'goleet prompt render --template'.`,
+}
+
+var promptRenderCmd = &cobra.Command{
+	Use:   "render",
+	Short: "Preview the suggestion prompt with your current data",
+	Run: func(cmd *cobra.Command, args []string) {
+		store := data.NewStore()
+
+		if raw, _ := cmd.Flags().GetBool("template"); raw {
+			src, origin, err := gemini.PromptTemplateSource(store.TemplatesDir())
+			if err != nil {
+				fmt.Println("❌ Failed to read template:", err)
+				return
+			}
+			fmt.Printf("# template: %s\n%s", origin, src)
+			return
+		}
+
+		catalog, err := store.LoadCatalog()
+		if err != nil {
+			fmt.Println("❌ Failed to load problems:", err)
+			return
+		}
+		history, _ := store.LoadHistory()
+		solved, _ := store.LoadSolved()
+		attempts, _ := store.LoadAttempts()
+		skills := recommend.RateSkills(catalog, solved, attempts, time.Now())
+
+		filter := filterFromFlags(cmd)
+		warnUnsupportedFilters(catalog, filter)
+		poolSize, _ := cmd.Flags().GetInt("pool")
+		if poolSize < 1 {
+			fmt.Println("⚠️ --pool must be at least 1.")
+			return
+		}
+		candidates := recommend.Candidates(catalog, solved, history, skills, filter, poolSize)
+		if len(candidates) == 0 {
+			fmt.Println("⚠️ No unsolved problems match your filters.")
+			return
+		}
+
+		seriousness, _ := cmd.Flags().GetInt("seriousness")
+		tokenBudget, _ := cmd.Flags().GetInt("max-prompt-tokens")
+		useTools, _ := cmd.Flags().GetBool("tools")
+
+		prompt, err := gemini.BuildPrompt(gemini.PromptInput{
+			Solved:       solved,
+			History:      history,
+			Catalog:      catalog,
+			Candidates:   candidates,
+			Filter:       filter,
+			Seriousness:  seriousness,
+			TokenBudget:  tokenBudget,
+			TemplatesDir: store.TemplatesDir(),
+			Tools:        useTools,
+		})
+		if err != nil {
+			fmt.Println("❌ Failed to render prompt:", err)
+			return
+		}
+
+		_, origin, _ := gemini.PromptTemplateSource(store.TemplatesDir())
+		fmt.Println(prompt.Text)
+		fmt.Printf("--- template: %s, ~%d tokens, %d candidates ---\n", origin, prompt.Tokens, len(prompt.Candidates))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(promptCmd)
+	promptCmd.AddCommand(promptRenderCmd)
+
+	promptRenderCmd.Flags().Bool("template", false, "Print the template source instead of rendering it")
+	promptRenderCmd.Flags().Int("seriousness", 1, "Seriousness mode to render (1-3)")
+	promptRenderCmd.Flags().String("difficulty", "", "Render as if suggesting this difficulty only")
+	promptRenderCmd.Flags().String("topic", "", "Render as if suggesting this topic only")
+	promptRenderCmd.Flags().Bool("free-only", false, "Render as if skipping paid-only problems")
+	promptRenderCmd.Flags().Float64("min-acceptance", 0, "Render with this minimum acceptance rate")
+	promptRenderCmd.Flags().Int("pool", 30, "Number of candidate problems")
+	promptRenderCmd.Flags().Bool("tools", false, "Render the variant used by suggest --tools")
+	promptRenderCmd.Flags().Int("max-prompt-tokens", gemini.DefaultTokenBudget, "Approximate token budget for the prompt")
+}
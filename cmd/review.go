@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/gemini"
+	"github.com/spf13/cobra"
+)
+
+// maxReviewBytes keeps review requests to a sane size.
+const maxReviewBytes = 64 * 1024
+
+var reviewCmd = &cobra.Command{
+	Use:   "review-code [questionID] [file]",
+	Short: "Get an AI review of your solution (correctness, complexity, style)",
+	Long: `Sends the problem metadata and your solution file to the AI and prints its
+review. The review is saved in data/notes/<id>/ next to the problem's notes.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		store := data.NewStore()
+		catalog, err := store.LoadCatalog()
+		if err != nil {
+			fmt.Println("❌ Failed to load problems:", err)
+			return
+		}
+
+		problem, ok := catalog.ByID(args[0])
+		if !ok {
+			fmt.Println("⚠️ Problem ID not found:", args[0])
+			return
+		}
+
+		file := args[1]
+		code, err := os.ReadFile(file)
+		if err != nil {
+			fmt.Println("❌ Failed to read solution:", err)
+			return
+		}
+		if len(code) > maxReviewBytes {
+			fmt.Printf("⚠️ %s is larger than %d KB; review a smaller file\n", file, maxReviewBytes/1024)
+			return
+		}
+
+		client, err := newAIClient(store, "review-code")
+		if err != nil {
+			fmt.Println("❌ Cannot use Gemini:", err)
+			return
+		}
+
+		if noCache, _ := cmd.Flags().GetBool("no-cache"); noCache {
+			client.Cache = nil
+		}
+
+		ctx, stop := interruptContext()
+		defer stop()
+
+		var review *gemini.CodeReview
+		err = withSpinner(func() error {
+			var err error
+			review, err = client.ReviewCode(ctx, problem, langFromExt(file), string(code))
+			return err
+		})
+		if errors.Is(err, context.Canceled) {
+			fmt.Println("🛑 Cancelled.")
+			return
+		}
+		if err != nil {
+			fmt.Println("❌ Review failed:", err)
+			return
+		}
+
+		markdown := review.Markdown(problem, file)
+		fmt.Println(markdown)
+
+		path, err := store.SaveReview(problem.ID, time.Now(), markdown)
+		if err != nil {
+			fmt.Println("⚠️ Failed to save review:", err)
+			return
+		}
+		fmt.Println("📝 Saved to", path)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(reviewCmd)
+
+	reviewCmd.Flags().Bool("no-cache", false, "Always call Gemini, ignoring cached answers")
+}
+
+// langFromExt names the language of a source file for the prompt.
+func langFromExt(file string) string {
+	switch ext := strings.ToLower(filepath.Ext(file)); ext {
+	case ".go":
+		return "go"
+	case ".py":
+		return "python"
+	case ".java":
+		return "java"
+	case ".cpp", ".cc", ".cxx", ".hpp":
+		return "cpp"
+	case ".js":
+		return "javascript"
+	case ".ts":
+		return "typescript"
+	case ".rs":
+		return "rust"
+	case "":
+		return "text"
+	default:
+		return strings.TrimPrefix(ext, ".")
+	}
+}
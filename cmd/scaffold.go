@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/leetcode"
+	"github.com/chhand2808/goleet/internal/scaffold"
+	"github.com/spf13/cobra"
+)
+
+var scaffoldCmd = &cobra.Command{
+	Use:   "scaffold [questionID]",
+	Short: "Create a local workspace with starter code and tests for a problem",
+	Long: `Creates workspace/<id>-<slug>/ with a solution file, a table-driven test and
+a README linking the problem. The scaffold time is kept in .goleet.json, so
+goleet test can time the solve; --force leaves it alone.
+
+Test cases are filled in from the problem's examples: from data/problems.json
+when it has them ("examples": [{"input": "...", "output": "..."}], refreshed
+with "cd data && go run ./gen -examples"), otherwise fetched from leetcode.com.
+Offline, or for design problems, the table starts empty with a commented
+sample case: add cases by hand before running goleet test.
+
+Templates can be overridden per language by placing files with the same
+name in data/templates/<lang>/ (e.g. data/templates/go/solution.go.tmpl).`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		lang, _ := cmd.Flags().GetString("lang")
+		dir, _ := cmd.Flags().GetString("dir")
+		force, _ := cmd.Flags().GetBool("force")
+
+		store := data.NewStore()
+		catalog, err := store.LoadCatalog()
+		if err != nil {
+			fmt.Println("❌ Failed to load problems:", err)
+			return
+		}
+
+		problem, ok := catalog.Lookup(args[0])
+		if !ok {
+			fmt.Println("⚠️ Problem ID not found:", args[0])
+			return
+		}
+
+		_, exists := scaffold.FindWorkspace(dir, problem.ID)
+		if len(problem.Examples) == 0 && !problem.PaidOnly && (!exists || force) {
+			problem.Examples = fetchExamples(problem)
+		}
+
+		files, err := scaffold.Generate(problem, scaffold.Options{
+			Lang:         strings.ToLower(lang),
+			Dir:          dir,
+			TemplatesDir: store.TemplatesDir(),
+			Force:        force,
+		})
+		if err != nil {
+			fmt.Println("❌ Failed to scaffold:", err)
+			return
+		}
+
+		workspace := scaffold.WorkspaceDir(dir, problem)
+		if len(files) == 0 {
+			fmt.Println("ℹ️ Workspace already exists, nothing written (use --force to overwrite):", workspace)
+			return
+		}
+
+		fmt.Printf("📁 Scaffolded %s. %s in %s\n", problem.ID, problem.Title, workspace)
+		for _, f := range files {
+			fmt.Println("  +", f)
+		}
+		if len(problem.Examples) == 0 {
+			fmt.Println("ℹ️ No examples found for this problem: the test table is empty, add cases by hand.")
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(scaffoldCmd)
+
+	scaffoldCmd.Flags().String("lang", "go", "Language of the starter code ("+strings.Join(scaffold.Languages(), ", ")+")")
+	scaffoldCmd.Flags().String("dir", scaffold.DefaultDir, "Directory where workspaces are created")
+	scaffoldCmd.Flags().Bool("force", false, "Overwrite files in an existing workspace")
+}
+
+// fetchExamples gets the sample cases the catalog lacks from leetcode.com;
+// scaffolding goes on with an empty table when that fails.
+func fetchExamples(p data.Problem) []data.Example {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	examples, err := leetcode.NewClient().Examples(ctx, p.TitleSlug)
+	if err != nil {
+		fmt.Println("⚠️ Could not fetch examples:", err)
+		return nil
+	}
+	return examples
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/similar"
+	"github.com/spf13/cobra"
+)
+
+var showCmd = &cobra.Command{
+	Use:   "show [questionID|slug]",
+	Short: "Show a problem's details, your progress and similar problems",
+	Long: `Shows a problem's metadata and your progress on it, followed by related
+problems found offline from topic tags, titles and statements.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		n, _ := cmd.Flags().GetInt("similar")
+
+		store := data.NewStore()
+		catalog, err := store.LoadCatalog()
+		if err != nil {
+			fmt.Println("❌ Failed to load problems:", err)
+			return
+		}
+
+		problem, ok := catalog.Lookup(args[0])
+		if !ok {
+			fmt.Println("⚠️ Problem not found:", args[0])
+			return
+		}
+
+		solved, _ := store.LoadSolved()
+		solvedOn := map[string]data.SolvedProblem{}
+		for _, s := range solved {
+			solvedOn[s.ID] = s
+		}
+
+		fmt.Printf("📘 %s. %s (%s)\n", problem.ID, problem.Title, problem.Difficulty)
+		fmt.Println("Topics:", strings.Join(problem.Topics(), ", "))
+		fmt.Println("Link:", problem.Link())
+		printProblemMeta(problem)
+
+		if s, ok := solvedOn[problem.ID]; ok {
+			if s.Hints > 0 {
+				fmt.Printf("✅ Solved on %s (with hints up to level %d)\n", s.Date, s.Hints)
+			} else {
+				fmt.Printf("✅ Solved on %s\n", s.Date)
+			}
+		} else {
+			fmt.Println("⬜ Not solved yet")
+		}
+
+		if attempts, err := store.LoadAttempts(); err == nil {
+			count, passed := 0, 0
+			for _, a := range attempts {
+				if a.ID == problem.ID {
+					count++
+					if a.Passed {
+						passed++
+					}
+				}
+			}
+			if count > 0 {
+				fmt.Printf("🧪 Test runs: %d (%d passing)\n", count, passed)
+			}
+		}
+
+		if len(problem.SimilarQuestions) > 0 {
+			titles := make([]string, 0, len(problem.SimilarQuestions))
+			for _, sq := range problem.SimilarQuestions {
+				titles = append(titles, sq.Title)
+			}
+			fmt.Println("🔗 Listed as similar on LeetCode:", strings.Join(titles, ", "))
+		}
+
+		matches := similar.ForCatalog(catalog).Similar(problem.ID, n)
+		if len(matches) == 0 {
+			return
+		}
+		fmt.Println()
+		fmt.Println("🧭 Similar problems:")
+		for _, m := range matches {
+			p, ok := catalog.ByID(m.ID)
+			if !ok {
+				continue
+			}
+			mark := ""
+			if _, done := solvedOn[p.ID]; done {
+				mark = " ✅"
+			}
+			fmt.Printf("   %s. %s (%s) %.2f%s\n", p.ID, p.Title, p.Difficulty, m.Score, mark)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(showCmd)
+
+	showCmd.Flags().Int("similar", 5, "How many similar problems to list")
+}
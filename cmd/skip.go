@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/spf13/cobra"
+)
+
+var skipCmd = &cobra.Command{
+	Use:   "skip [questionID]",
+	Short: "Reject the last suggestion (or a given problem) with a reason",
+	Long: `Marks the most recent suggestion as skipped. The reason shapes future picks:
+  too-hard        prefer easier problems
+  seen-it         never suggest this problem again
+  not-interested  suggest fewer problems from its topics`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		reason, _ := cmd.Flags().GetString("reason")
+		reason = strings.ToLower(reason)
+		if reason != "" && !slices.Contains(data.SkipReasons, reason) {
+			fmt.Printf("⚠️ Unknown reason %q (use %s)\n", reason, strings.Join(data.SkipReasons, ", "))
+			return
+		}
+
+		id := ""
+		if len(args) == 1 {
+			id = args[0]
+		}
+
+		store := data.NewStore()
+		var skipped data.HistoryEntry
+		found, err := store.UpdateHistory(id, func(h *data.HistoryEntry) {
+			h.Status = data.StatusSkipped
+			h.SkipReason = reason
+			skipped = *h
+		})
+		if err != nil {
+			fmt.Println("❌ Failed to update history:", err)
+			return
+		}
+		if !found {
+			fmt.Println("⚠️ Nothing to skip. Try running: suggest")
+			return
+		}
+
+		msg := fmt.Sprintf("⏭️ Skipped: %s (%s)", skipped.Title, skipped.ID)
+		if reason != "" {
+			msg += " - " + reason
+		}
+		fmt.Println(msg)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(skipCmd)
+
+	skipCmd.Flags().String("reason", "", "Why: "+strings.Join(data.SkipReasons, ", "))
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/spf13/cobra"
+)
+
+var snoozeCmd = &cobra.Command{
+	Use:   "snooze [questionID] [duration]",
+	Short: "Hide a problem from suggestions for a while (e.g. 3d, 2w)",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		days, err := parseDays(args[1])
+		if err != nil {
+			fmt.Println("⚠️", err)
+			return
+		}
+
+		store := data.NewStore()
+		catalog, err := store.LoadCatalog()
+		if err != nil {
+			fmt.Println("❌ Failed to load problems:", err)
+			return
+		}
+
+		problem, ok := catalog.ByID(args[0])
+		if !ok {
+			fmt.Println("⚠️ Problem ID not found:", args[0])
+			return
+		}
+
+		until := time.Now().AddDate(0, 0, days).Format("2006-01-02")
+
+		found, err := store.UpdateHistory(problem.ID, func(h *data.HistoryEntry) {
+			h.SnoozedUntil = until
+		})
+		if err == nil && !found {
+			// never suggested: remember the snooze as its own entry
+			entry := data.NewHistoryEntry(problem.ID, problem.Title, "")
+			entry.Status = data.StatusSnoozed
+			entry.SnoozedUntil = until
+			err = store.AppendHistory(entry, historyLimit)
+		}
+		if err != nil {
+			fmt.Println("❌ Failed to update history:", err)
+			return
+		}
+
+		fmt.Printf("💤 Snoozed %s (%s) until %s\n", problem.Title, problem.ID, formatRelativeDate(until))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(snoozeCmd)
+}
+
+// parseDays turns "3d", "2w" or "5" into a number of days.
+func parseDays(s string) (int, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	mult := 1
+	switch {
+	case strings.HasSuffix(s, "d"):
+		s = strings.TrimSuffix(s, "d")
+	case strings.HasSuffix(s, "w"):
+		s = strings.TrimSuffix(s, "w")
+		mult = 7
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid duration %q (use e.g. 3d or 2w)", s)
+	}
+	return n * mult, nil
+}
@@ -1,13 +1,12 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
-	"os"
 	"sort"
 	"time"
 
 	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/recommend"
 
 	"github.com/spf13/cobra"
 )
@@ -16,24 +15,29 @@ var statsCmd = &cobra.Command{
 	Use:   "stats",
 	Short: "Show your solving stats",
 	Run: func(cmd *cobra.Command, args []string) {
-		showStats()
+		skills, _ := cmd.Flags().GetBool("skills")
+		showStats(skills)
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(statsCmd)
+
+	statsCmd.Flags().Bool("skills", false, "Also show per-topic skill ratings with trends")
 }
 
-func showStats() {
+func showStats(withSkills bool) {
+	store := data.NewStore()
+
 	// Load solved problems
-	solved, err := loadSolved()
+	solved, err := store.LoadSolved()
 	if err != nil {
 		fmt.Println("❌ Failed to load solved problems:", err)
 		return
 	}
 
 	// Load all problems (for difficulty count)
-	allProblems, err := loadAllProblems()
+	catalog, err := store.LoadCatalog()
 	if err != nil {
 		fmt.Println("❌ Failed to load problems.json:", err)
 		return
@@ -42,9 +46,17 @@ func showStats() {
 	totalSolved := len(solved)
 	easy, medium, hard := 0, 0, 0
 
+	// Separate unaided solves from solves after hints
+	withHints := 0
+	for _, s := range solved {
+		if s.Hints > 0 {
+			withHints++
+		}
+	}
+
 	// Count difficulty
 	for _, s := range solved {
-		if p, ok := allProblems[s.ID]; ok {
+		if p, ok := catalog.ByID(s.ID); ok {
 			switch p.Difficulty {
 			case "Easy":
 				easy++
@@ -58,38 +70,40 @@ func showStats() {
 
 	currentStreak, longestStreak := calculateStreak(solved)
 
-	drawBoxedStats(totalSolved, easy, medium, hard, currentStreak, longestStreak)
-}
+	drawBoxedStats(totalSolved, easy, medium, hard, totalSolved-withHints, withHints, currentStreak, longestStreak)
 
-func loadSolved() ([]data.SolvedProblem, error) {
-	file, err := os.ReadFile("data/solved.json")
-	if err != nil {
-		// No file yet? Return empty list
-		return []data.SolvedProblem{}, nil
+	if withSkills {
+		attempts, err := store.LoadAttempts()
+		if err != nil {
+			fmt.Println("❌ Failed to load attempts:", err)
+			return
+		}
+		drawSkills(recommend.RateSkills(catalog, solved, attempts, time.Now()))
 	}
-
-	var solved []data.SolvedProblem
-	err = json.Unmarshal(file, &solved)
-	return solved, err
 }
 
-func loadAllProblems() (map[string]data.Problem, error) {
-	file, err := os.ReadFile("data/problems.json")
-	if err != nil {
-		return nil, err
-	}
+// trendThreshold is the rating change below which a topic counts as steady.
+const trendThreshold = 5
 
-	var problems []data.Problem
-	err = json.Unmarshal(file, &problems)
-	if err != nil {
-		return nil, err
+func drawSkills(skills recommend.Skills) {
+	if len(skills) == 0 {
+		fmt.Println("🎯 No rated topics yet: solve or test a problem first.")
+		return
 	}
 
-	problemMap := make(map[string]data.Problem)
-	for _, p := range problems {
-		problemMap[p.ID] = p
+	fmt.Printf("🎯 Topic skills (Elo, start %.0f; trend over %d days)\n", recommend.InitialRating, int(recommend.TrendWindow.Hours()/24))
+	fmt.Printf("%-24s %6s %6s  %s\n", "Topic", "Rating", "Games", "Trend")
+	for _, sk := range skills.Sorted() {
+		arrow := "→"
+		switch {
+		case sk.Trend >= trendThreshold:
+			arrow = "↑"
+		case sk.Trend <= -trendThreshold:
+			arrow = "↓"
+		}
+		fmt.Printf("%-24s %6.0f %6d  %s %+.0f\n", sk.Topic, sk.Rating, sk.Games, arrow, sk.Trend)
 	}
-	return problemMap, nil
+	fmt.Println()
 }
 
 func calculateStreak(solved []data.SolvedProblem) (int, int) {
@@ -146,7 +160,7 @@ func calculateStreak(solved []data.SolvedProblem) (int, int) {
 	return currentStreak, longestStreak
 }
 
-func drawBoxedStats(total, easy, medium, hard, current, longest int) {
+func drawBoxedStats(total, easy, medium, hard, unaided, withHints, current, longest int) {
 	top := "╔══ 📊 STATS ═════════════════════════╗"
 	btm := "╚════════════════════════════════════╝"
 
@@ -154,6 +168,7 @@ func drawBoxedStats(total, easy, medium, hard, current, longest int) {
 	fmt.Println(top)
 	fmt.Printf("║ Total Solved        : %-12d ║\n", total)
 	fmt.Printf("║ Easy / Med / Hard   : %d / %d / %d      ║\n", easy, medium, hard)
+	fmt.Printf("║ Unaided / w/ Hints  : %d / %d          ║\n", unaided, withHints)
 	fmt.Printf("║ 🔥 Current Streak    : %-12d ║\n", current)
 	fmt.Printf("║ 🏆 Longest Streak     : %-12d ║\n", longest)
 	fmt.Println(btm)
@@ -1,11 +1,19 @@
 package cmd
 
 import (
+	"bufio"
+	"context"
+	"errors"
 	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/chhand2808/goleet/internal/data"
 	"github.com/chhand2808/goleet/internal/gemini"
+	"github.com/chhand2808/goleet/internal/recommend"
 	utils "github.com/chhand2808/goleet/internal/util"
 	"github.com/spf13/cobra"
 )
@@ -23,6 +31,42 @@ func init() {
 
 	// add debug flag
 	suggestCmd.Flags().Bool("debug", false, "Enable debug logging")
+
+	// filters (shared by the AI and offline recommenders)
+	suggestCmd.Flags().String("difficulty", "", "Only suggest problems of this difficulty (Easy, Medium, Hard)")
+	suggestCmd.Flags().String("topic", "", "Only suggest problems with this topic tag")
+	suggestCmd.Flags().Bool("free-only", false, "Skip paid-only problems")
+	suggestCmd.Flags().Float64("min-acceptance", 0, "Minimum acceptance rate in percent")
+	suggestCmd.Flags().Bool("offline", false, "Use the local recommender instead of Gemini")
+	suggestCmd.Flags().Int("pick", 0, "Take the Nth AI suggestion without asking")
+	suggestCmd.Flags().Int("pool", 30, "Number of candidate problems the AI chooses from")
+	suggestCmd.Flags().Int("max-prompt-tokens", gemini.DefaultTokenBudget, "Approximate token budget for the prompt")
+	suggestCmd.Flags().Bool("show-prompt", false, "Print the prompt without calling the API")
+	suggestCmd.Flags().Bool("no-cache", false, "Always call Gemini, ignoring cached answers")
+	suggestCmd.Flags().Bool("tools", false, "Let the AI search the catalog and your history itself")
+	suggestCmd.Flags().Int("max-tool-rounds", gemini.DefaultToolRounds, "Cap on tool-calling rounds with --tools")
+	suggestCmd.Flags().Duration("timeout", 0, "Deadline for the Gemini call incl. retries (default from config, 60s)")
+}
+
+func filterFromFlags(cmd *cobra.Command) data.Filter {
+	difficulty, _ := cmd.Flags().GetString("difficulty")
+	topic, _ := cmd.Flags().GetString("topic")
+	freeOnly, _ := cmd.Flags().GetBool("free-only")
+	minAcceptance, _ := cmd.Flags().GetFloat64("min-acceptance")
+
+	return data.Filter{
+		Difficulty:    difficulty,
+		Topic:         topic,
+		FreeOnly:      freeOnly,
+		MinAcceptance: minAcceptance,
+	}
+}
+
+// warnUnsupportedFilters points out filters the catalog has no data for.
+func warnUnsupportedFilters(catalog *data.Catalog, filter data.Filter) {
+	for _, f := range filter.Unsupported(catalog) {
+		fmt.Printf("⚠️ Ignoring %s: data/problems.json lacks this metadata.\n", f)
+	}
 }
 
 func runAISuggest(cmd *cobra.Command) {
@@ -39,18 +83,97 @@ func runAISuggest(cmd *cobra.Command) {
 	store := data.NewStore()
 
 	// Load problems
-	problems, err := store.LoadProblems()
+	catalog, err := store.LoadCatalog()
 	if err != nil {
 		utils.Error("Failed to load problems: %v", err)
 		return
 	}
-	utils.Debug("Loaded %d problems", len(problems))
+	utils.Debug("Loaded %d problems", catalog.Len())
 
 	// Load history + solved
 	history, _ := store.LoadHistory()
 	solved, _ := store.LoadSolved()
 	utils.Debug("Loaded %d solved, %d history", len(solved), len(history))
 
+	// per-topic ratings steer both recommenders to the edge of the user's ability
+	attempts, _ := store.LoadAttempts()
+	skills := recommend.RateSkills(catalog, solved, attempts, time.Now())
+
+	filter := filterFromFlags(cmd)
+	utils.Debug("Filters: %s", filter.Describe())
+	warnUnsupportedFilters(catalog, filter)
+
+	offline, _ := cmd.Flags().GetBool("offline")
+	if offline {
+		runLocalSuggest(store, catalog, solved, history, skills, filter)
+		return
+	}
+
+	// the AI only ranks this pool, so every answer is unsolved and matches the filters
+	poolSize, _ := cmd.Flags().GetInt("pool")
+	if poolSize < 1 {
+		fmt.Println("⚠️ --pool must be at least 1.")
+		return
+	}
+	candidates := recommend.Candidates(catalog, solved, history, skills, filter, poolSize)
+	if len(candidates) == 0 {
+		fmt.Println("⚠️ No unsolved problems match your filters.")
+		return
+	}
+	utils.Debug("Candidate pool: %d problems", len(candidates))
+
+	tokenBudget, _ := cmd.Flags().GetInt("max-prompt-tokens")
+	useTools, _ := cmd.Flags().GetBool("tools")
+
+	// --show-prompt: print what would be sent and stop
+	if showPrompt, _ := cmd.Flags().GetBool("show-prompt"); showPrompt {
+		prompt, err := gemini.BuildPrompt(gemini.PromptInput{
+			Solved:       solved,
+			History:      history,
+			Catalog:      catalog,
+			Candidates:   candidates,
+			Filter:       filter,
+			Seriousness:  1,
+			TokenBudget:  tokenBudget,
+			TemplatesDir: store.TemplatesDir(),
+			Tools:        useTools,
+		})
+		if err != nil {
+			fmt.Println("❌ Failed to build prompt:", err)
+			return
+		}
+		fmt.Println(prompt.Text)
+		fmt.Printf("--- ~%d tokens, %d candidates ---\n", prompt.Tokens, len(prompt.Candidates))
+		return
+	}
+
+	client, err := newAIClient(store, "suggest")
+	if errors.Is(err, gemini.ErrBudgetExceeded) {
+		fmt.Printf("⚠️ %v, falling back to offline suggestion.\n", err)
+		runLocalSuggest(store, catalog, solved, history, skills, filter)
+		return
+	}
+	if err != nil {
+		utils.Error("Cannot use Gemini: %v", err)
+		fmt.Println("⚠️ Gemini unavailable, falling back to offline suggestion.")
+		runLocalSuggest(store, catalog, solved, history, skills, filter)
+		return
+	}
+	if noCache, _ := cmd.Flags().GetBool("no-cache"); noCache {
+		client.Cache = nil
+	}
+	if timeout, _ := cmd.Flags().GetDuration("timeout"); timeout > 0 {
+		client.Timeout = timeout
+	}
+	if useTools {
+		client.Tools = gemini.CatalogTools(catalog, solved, history, skills, filter)
+		client.Tools.MaxRounds, _ = cmd.Flags().GetInt("max-tool-rounds")
+	}
+
+	// Ctrl-C cancels the in-flight request and any backoff wait
+	ctx, stopSignals := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stopSignals()
+
 	// seriousness = how strict the AI should be
 	seriousness := 1
 	var final []data.AISuggestion
@@ -58,8 +181,24 @@ func runAISuggest(cmd *cobra.Command) {
 	for attempt := 1; attempt <= 3; attempt++ {
 		utils.Info("Gemini Call Attempt %d (seriousness=%d)", attempt, seriousness)
 
-		prompt := gemini.BuildPrompt(solved, history, problems, seriousness)
-		utils.Debug("PROMPT SENT TO GEMINI:\n%s", prompt)
+		prompt, err := gemini.BuildPrompt(gemini.PromptInput{
+			Solved:       solved,
+			History:      history,
+			Catalog:      catalog,
+			Candidates:   candidates,
+			Filter:       filter,
+			Seriousness:  seriousness,
+			TokenBudget:  tokenBudget,
+			TemplatesDir: store.TemplatesDir(),
+			Tools:        useTools,
+		})
+		if err != nil {
+			// a broken override will not fix itself; use the offline recommender
+			utils.Error("Cannot build prompt: %v", err)
+			fmt.Println("❌ Failed to build prompt:", err)
+			break
+		}
+		utils.Debug("PROMPT SENT TO GEMINI (~%d tokens):\n%s", prompt.Tokens, prompt.Text)
 
 		// Start spinner ONLY in production mode
 		var stop chan bool
@@ -67,16 +206,30 @@ func runAISuggest(cmd *cobra.Command) {
 			stop = utils.StartSpinner()
 		}
 
-		ai, err := gemini.GetSuggestions(prompt)
+		ai, err := client.GetSuggestions(ctx, prompt.Text, prompt.Candidates)
 
-		// Stop spinner
-		if utils.IsProduction && !utils.DebugEnabled {
-			utils.StopSpinner(stop)
-			fmt.Println() // move to next line
+		// Stop spinner (clears its line)
+		utils.StopSpinner(stop)
+
+		if errors.Is(err, context.Canceled) {
+			fmt.Println("🛑 Cancelled.")
+			return
+		}
+
+		var schemaErr *gemini.SchemaError
+		if errors.As(err, &schemaErr) {
+			utils.Warn("Gemini response did not match the schema: %s", schemaErr.Reason)
+			utils.Debug("Raw response: %s", schemaErr.Raw)
+			seriousness++
+			continue
 		}
 
 		if err != nil {
 			utils.Warn("Gemini error: %v", err)
+			if !errors.Is(err, gemini.ErrInvalidOutput) {
+				// fatal, or still failing after the client's own retries
+				break
+			}
 			seriousness++
 			continue
 		}
@@ -84,7 +237,7 @@ func runAISuggest(cmd *cobra.Command) {
 		utils.Debug("Gemini returned %d suggestions", len(ai))
 
 		// filter invalid ones
-		valid := filterAISuggestions(ai, solved, history, problems)
+		valid := filterAISuggestions(ai, solved, history, catalog, filter)
 		utils.Debug("%d suggestions valid after filtering", len(valid))
 
 		if len(valid) > 0 {
@@ -99,59 +252,109 @@ func runAISuggest(cmd *cobra.Command) {
 
 	if len(final) == 0 {
 		utils.Error("No AI suggestions available after retries")
-		fmt.Println("⚠️ No AI suggestions available.")
+		fmt.Println("⚠️ No AI suggestions available, falling back to offline suggestion.")
+		runLocalSuggest(store, catalog, solved, history, skills, filter)
 		return
 	}
 
-	// pick the first suggestion
-	chosen := final[0]
+	// list every valid suggestion and let the user choose
+	fmt.Println("🧠 AI Suggested:")
+	for i, sug := range final {
+		fmt.Printf("%d) %d. %s\n", i+1, sug.Number, sug.Title)
+		if sug.Reason != "" {
+			fmt.Printf("   💡 %s\n", sug.Reason)
+		}
+	}
+
+	pick, _ := cmd.Flags().GetInt("pick")
+	idx := choosePick(pick, len(final))
+	chosen := final[idx]
 
 	utils.Info("Chosen suggestion: %d - %s", chosen.Number, chosen.Title)
 
-	fmt.Println("🧠 AI Suggested:")
-	fmt.Printf("%d. %s\n", chosen.Number, chosen.Title)
+	fmt.Println()
+	fmt.Printf("👉 %d. %s\n", chosen.Number, chosen.Title)
 	fmt.Println("Topics:", chosen.Topics)
-	fmt.Printf(
-		"Link: https://leetcode.com/problems/%s/\n",
-		strings.ToLower(strings.ReplaceAll(chosen.Title, " ", "-")),
-	)
-
-	// Save history
-	err = store.AppendHistory(
-		data.NewHistoryEntry(
-			fmt.Sprint(chosen.Number),
-			chosen.Title,
-			"",
-		),
-		10,
-	)
+	if p, ok := catalog.ByID(fmt.Sprint(chosen.Number)); ok {
+		printProblemMeta(p)
+		fmt.Println("Link:", p.Link())
+	}
 
-	if err != nil {
+	// Save history: the others as "offered", the chosen one last (most recent)
+	for i, sug := range final {
+		if i == idx {
+			continue
+		}
+		saveHistory(store, sug, data.StatusOffered)
+	}
+	saveHistory(store, chosen, data.StatusSuggested)
+}
+
+// historyLimit is how many entries history.json keeps.
+const historyLimit = 30
+
+func saveHistory(store *data.Store, sug data.AISuggestion, status string) {
+	entry := data.NewHistoryEntry(fmt.Sprint(sug.Number), sug.Title, "")
+	entry.Status = status
+	entry.Reason = sug.Reason
+
+	if err := store.AppendHistory(entry, historyLimit); err != nil {
 		utils.Warn("Failed to update history: %v", err)
 	} else {
 		utils.Info("History updated successfully.")
 	}
 }
 
+// choosePick returns the 0-based index of the suggestion to use: --pick N
+// when given, otherwise asks on an interactive terminal, defaulting to 1.
+func choosePick(pick, n int) int {
+	if pick >= 1 && pick <= n {
+		return pick - 1
+	}
+	if pick != 0 {
+		utils.Warn("--pick %d out of range, using 1", pick)
+		return 0
+	}
+	if n == 1 || !isInteractive() {
+		return 0
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	for {
+		fmt.Printf("Pick one [1-%d] (Enter = 1): ", n)
+		line, err := reader.ReadString('\n')
+		line = strings.TrimSpace(line)
+		if line == "" || err != nil {
+			return 0
+		}
+		if v, err := strconv.Atoi(line); err == nil && v >= 1 && v <= n {
+			return v - 1
+		}
+	}
+}
+
+// isInteractive reports whether stdin is a terminal.
+func isInteractive() bool {
+	info, err := os.Stdin.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
 func filterAISuggestions(
 	ai []data.AISuggestion,
 	solved []data.SolvedProblem,
 	history []data.HistoryEntry,
-	problems []data.Problem,
+	catalog *data.Catalog,
+	filter data.Filter,
 ) []data.AISuggestion {
 
-	block := map[string]bool{}
+	// block recent history (and snoozed / seen-it problems)
+	block := data.BlockedIDs(history, time.Now())
 
 	// block solved
 	for _, s := range solved {
 		block[s.ID] = true
 	}
 
-	// block recent history
-	for _, h := range history {
-		block[h.ID] = true
-	}
-
 	out := []data.AISuggestion{}
 
 	for _, p := range ai {
@@ -163,22 +366,59 @@ func filterAISuggestions(
 		}
 
 		// ensure exists in problem db
-		if !existsInProblemDB(id, problems) {
+		prob, ok := catalog.ByID(id)
+		if !ok {
 			utils.Debug("AI suggested problem %s not found in DB", id)
 			continue
 		}
 
+		// enforce user filters even if the model ignored them
+		if !filter.Match(prob) {
+			utils.Debug("AI suggested problem %s does not match filters", id)
+			continue
+		}
+
 		out = append(out, p)
 	}
 
 	return out
 }
 
-func existsInProblemDB(id string, problems []data.Problem) bool {
-	for _, p := range problems {
-		if p.ID == id {
-			return true
-		}
+// runLocalSuggest picks a problem with the offline recommender.
+func runLocalSuggest(
+	store *data.Store,
+	catalog *data.Catalog,
+	solved []data.SolvedProblem,
+	history []data.HistoryEntry,
+	skills recommend.Skills,
+	filter data.Filter,
+) {
+	picks := recommend.Local(catalog, solved, history, skills, filter, 1)
+	if len(picks) == 0 {
+		fmt.Println("⚠️ No unsolved problems match your filters.")
+		return
+	}
+
+	chosen := picks[0]
+	utils.Info("Chosen offline suggestion: %s - %s", chosen.ID, chosen.Title)
+
+	fmt.Println("🎯 Suggested:")
+	fmt.Printf("%s. %s (%s)\n", chosen.ID, chosen.Title, chosen.Difficulty)
+	fmt.Println("Topics:", chosen.Topics())
+	printProblemMeta(chosen)
+	fmt.Println("Link:", chosen.Link())
+
+	if err := store.AppendHistory(data.NewHistoryEntry(chosen.ID, chosen.Title, ""), historyLimit); err != nil {
+		utils.Warn("Failed to update history: %v", err)
+	}
+}
+
+// printProblemMeta prints the optional catalog fields that are known.
+func printProblemMeta(p data.Problem) {
+	if p.AcRate > 0 {
+		fmt.Printf("Acceptance: %.1f%%\n", p.AcRate)
+	}
+	if p.PaidOnly {
+		fmt.Println("🔒 Paid-only problem")
 	}
-	return false
 }
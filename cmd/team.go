@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/recommend"
+	"github.com/spf13/cobra"
+)
+
+var teamCmd = &cobra.Command{
+	Use:   "team",
+	Short: "Team leaderboard and problems nobody has solved",
+	Long: `Aggregates the solved logs of several data directories (e.g. each member's
+goleet data in a shared repo) into a leaderboard: solves this week (since
+Monday), total, hard solves, streaks and topic coverage. Then lists problems
+no one on the team has solved yet.
+
+Members come from --dirs, or from the members of data/team.json.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		dirs, _ := cmd.Flags().GetStringSlice("dirs")
+		n, _ := cmd.Flags().GetInt("unsolved")
+		difficulty, _ := cmd.Flags().GetString("difficulty")
+
+		store := data.NewStore()
+		catalog, err := store.LoadCatalog()
+		if err != nil {
+			fmt.Println("❌ Failed to load problems:", err)
+			return
+		}
+
+		team := data.TeamFromDirs(dirs)
+		if len(dirs) == 0 {
+			team, err = store.LoadTeam()
+			if err != nil {
+				fmt.Println("❌ Failed to load team:", err)
+				return
+			}
+		}
+		if len(team.Members) == 0 {
+			fmt.Println("⚠️ No team members: pass --dirs a,b,c or list members in data/team.json.")
+			return
+		}
+
+		monday := weekStart(time.Now()).Format("2006-01-02")
+		topics := len(catalog.Topics())
+
+		rows := []teamRow{}
+		solvedBy := map[string]bool{}
+		for _, m := range team.Members {
+			solved, found, err := data.LoadMemberSolved(m.Dir)
+			if err != nil {
+				fmt.Printf("⚠️ Skipping %s: %v\n", m.Name, err)
+				continue
+			}
+			if !found {
+				fmt.Printf("⚠️ Skipping %s: no solved.json in %s\n", m.Name, m.Dir)
+				continue
+			}
+
+			row := teamRow{Name: m.Name, Total: len(solved)}
+			for _, s := range solved {
+				solvedBy[s.ID] = true
+				if s.Date >= monday {
+					row.Week++
+				}
+				if p, ok := catalog.ByID(s.ID); ok && p.Difficulty == "Hard" {
+					row.Hard++
+				}
+			}
+			row.Streak, row.Longest = calculateStreak(solved)
+			for _, st := range recommend.Curriculum(catalog, solved) {
+				if st.State == recommend.TopicCovered {
+					row.Covered++
+				}
+			}
+			rows = append(rows, row)
+		}
+		if len(rows) == 0 {
+			return
+		}
+
+		sort.SliceStable(rows, func(i, j int) bool {
+			if rows[i].Week != rows[j].Week {
+				return rows[i].Week > rows[j].Week
+			}
+			if rows[i].Total != rows[j].Total {
+				return rows[i].Total > rows[j].Total
+			}
+			return rows[i].Name < rows[j].Name
+		})
+
+		title := "Team"
+		if team.Name != "" {
+			title = team.Name
+		}
+		fmt.Printf("🏆 %s leaderboard (week from %s)\n", title, monday)
+		fmt.Printf("%-2s %-16s %6s %6s %6s %10s %8s\n", "", "Member", "Week", "Total", "Hard", "Streak", "Topics")
+		for i, r := range rows {
+			fmt.Printf("%s %-16s %6d %6d %6d %10s %8s\n", rankLabel(i), r.Name, r.Week, r.Total, r.Hard,
+				fmt.Sprintf("%d (%d)", r.Streak, r.Longest), fmt.Sprintf("%d/%d", r.Covered, topics))
+		}
+
+		unsolved := []data.Problem{}
+		for _, p := range catalog.Problems {
+			if solvedBy[p.ID] || p.PaidOnly {
+				continue
+			}
+			if difficulty != "" && !strings.EqualFold(p.Difficulty, difficulty) {
+				continue
+			}
+			unsolved = append(unsolved, p)
+		}
+		fmt.Printf("\n🕳️ Nobody has solved %d problems", len(unsolved))
+		if difficulty != "" {
+			fmt.Printf(" (%s)", difficulty)
+		}
+		fmt.Println()
+		if n <= 0 || len(unsolved) == 0 {
+			return
+		}
+
+		// most asked in interviews first
+		sort.SliceStable(unsolved, func(i, j int) bool {
+			if unsolved[i].Frequency != unsolved[j].Frequency {
+				return unsolved[i].Frequency > unsolved[j].Frequency
+			}
+			return unsolved[i].LikeRatio() > unsolved[j].LikeRatio()
+		})
+		for _, p := range unsolved[:min(n, len(unsolved))] {
+			fmt.Printf("   %s. %s (%s)\n", p.ID, p.Title, p.Difficulty)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(teamCmd)
+
+	teamCmd.Flags().StringSlice("dirs", nil, "Data directories of the members, comma separated (default: data/team.json)")
+	teamCmd.Flags().Int("unsolved", 10, "How many problems nobody has solved to list")
+	teamCmd.Flags().String("difficulty", "", "Only list unsolved problems of this difficulty (Easy, Medium, Hard)")
+}
+
+type teamRow struct {
+	Name            string
+	Week, Total     int
+	Hard            int
+	Streak, Longest int
+	Covered         int // topics covered on the learning path
+}
+
+// weekStart returns midnight of the Monday starting t's week.
+func weekStart(t time.Time) time.Time {
+	days := (int(t.Weekday()) + 6) % 7 // Monday = 0
+	y, m, d := t.AddDate(0, 0, -days).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+func rankLabel(i int) string {
+	switch i {
+	case 0:
+		return "🥇"
+	case 1:
+		return "🥈"
+	case 2:
+		return "🥉"
+	}
+	return fmt.Sprintf("%2d", i+1) // as wide as a medal
+}
@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/runner"
+	"github.com/chhand2808/goleet/internal/scaffold"
+	"github.com/spf13/cobra"
+)
+
+var testCmd = &cobra.Command{
+	Use:   "test [questionID]",
+	Short: "Run the tests of a scaffolded solution",
+	Long: `Runs the tests in workspace/<id>-<slug>/ (go test for Go, pytest for Python),
+records the attempt in the solve log and offers to mark the problem as
+solved when every case passes. A scaffolded test table without cases
+tests nothing, so it does not count as a run.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		lang, _ := cmd.Flags().GetString("lang")
+		dir, _ := cmd.Flags().GetString("dir")
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		store := data.NewStore()
+		catalog, err := store.LoadCatalog()
+		if err != nil {
+			fmt.Println("❌ Failed to load problems:", err)
+			return
+		}
+
+		problem, ok := catalog.Lookup(args[0])
+		if !ok {
+			fmt.Println("⚠️ Problem ID not found:", args[0])
+			return
+		}
+
+		workspace, ok := scaffold.FindWorkspace(dir, problem.ID)
+		if !ok {
+			fmt.Printf("⚠️ No workspace for %s. Run: goleet scaffold %s\n", problem.ID, problem.ID)
+			return
+		}
+
+		if lang == "" {
+			lang, err = runner.DetectLang(workspace)
+			if err != nil {
+				fmt.Println("❌", err)
+				return
+			}
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		fmt.Printf("🧪 Running %s tests for %s. %s\n", lang, problem.ID, problem.Title)
+		res, err := runner.Run(ctx, workspace, lang)
+		if errors.Is(err, runner.ErrNoRunner) {
+			fmt.Printf("❌ Cannot run %s tests: %v\n", lang, err)
+			return
+		}
+		if err != nil {
+			fmt.Println("❌ Test run failed:", err)
+			return
+		}
+
+		printTestResult(res)
+		if res.Ran() == 0 {
+			return // nothing was tested, so there is no attempt to log
+		}
+
+		attempt := data.Attempt{
+			ID:         problem.ID,
+			At:         time.Now(),
+			Source:     "test",
+			Passed:     res.Passed(),
+			Cases:      res.Ran(),
+			Failed:     res.Failed(),
+			DurationMs: res.Elapsed.Milliseconds(),
+			Hints:      store.HintLevel(problem.ID),
+		}
+		// time since scaffolding; a workspace left for days counts as very slow
+		if started, ok := scaffold.StartedAt(workspace); ok && res.Passed() {
+			took := min(attempt.At.Sub(started), maxTrackedSolve)
+			attempt.SolveSeconds = int64(took.Seconds())
+		}
+		err = store.AppendAttempt(attempt)
+		if err != nil {
+			fmt.Println("⚠️ Failed to record attempt:", err)
+		}
+
+		if !res.Passed() || isSolved(store, problem.ID) {
+			return
+		}
+		if yes || confirm(fmt.Sprintf("All cases passed! Mark %s as solved?", problem.ID)) {
+			markDone(store, catalog, problem)
+		}
+	},
+}
+
+// maxTrackedSolve caps solve times taken from the scaffold time.
+const maxTrackedSolve = 3 * time.Hour
+
+func init() {
+	rootCmd.AddCommand(testCmd)
+
+	testCmd.Flags().String("lang", "", "Language to test (default: detected from the workspace)")
+	testCmd.Flags().String("dir", scaffold.DefaultDir, "Directory where workspaces are created")
+	testCmd.Flags().BoolP("yes", "y", false, "Mark as solved without asking when all cases pass")
+}
+
+func printTestResult(res runner.Result) {
+	if res.Ran() == 0 {
+		// an empty case table passes without testing anything
+		fmt.Println("⚠️ No test cases ran: fix the errors below or add cases to the test table first.")
+		fmt.Println(strings.TrimSpace(res.Output))
+		return
+	}
+
+	for _, c := range res.Cases {
+		icon := "✅"
+		switch {
+		case c.Skipped:
+			icon = "⏭️"
+		case !c.Passed:
+			icon = "❌"
+		}
+		fmt.Printf("%s %-40s %s\n", icon, c.Name, c.Elapsed.Round(time.Millisecond))
+	}
+
+	passed := res.Ran() - res.Failed()
+	fmt.Printf("\n%d/%d passed in %s\n", passed, res.Ran(), res.Elapsed.Round(time.Millisecond))
+
+	if res.Failed() > 0 {
+		fmt.Println()
+		fmt.Println(strings.TrimSpace(res.Output))
+	}
+}
+
+func isSolved(store *data.Store, id string) bool {
+	solved, err := store.LoadSolved()
+	if err != nil {
+		return false
+	}
+	for _, s := range solved {
+		if s.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// confirm asks a yes/no question on stdin; anything but y/yes is "no".
+func confirm(question string) bool {
+	fmt.Printf("%s [y/N]: ", question)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
@@ -0,0 +1,104 @@
+// Command gen precompiles problems.json into problems.gob, and the offline
+// similarity table into similar.gob; both are embedded in the binary.
+// Run it with `go generate ./data`.
+//
+// With -examples it first fetches the sample cases missing from
+// problems.json from leetcode.com and writes them back, so scaffolded
+// workspaces start with a filled test table: `cd data && go run ./gen -examples`.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/leetcode"
+	"github.com/chhand2808/goleet/internal/similar"
+)
+
+func main() {
+	fetch := flag.Bool("examples", false, "fetch missing examples from leetcode.com into problems.json")
+	flag.Parse()
+
+	raw, err := os.ReadFile("problems.json")
+	if err != nil {
+		fail(err)
+	}
+
+	var problems []data.Problem
+	if err := json.Unmarshal(raw, &problems); err != nil {
+		fail(err)
+	}
+
+	if *fetch {
+		fetchExamples(problems)
+		raw, err := json.MarshalIndent(problems, "", "    ")
+		if err != nil {
+			fail(err)
+		}
+		if err := os.WriteFile("problems.json", append(raw, '\n'), 0644); err != nil {
+			fail(err)
+		}
+	}
+
+	out, err := os.Create("problems.gob")
+	if err != nil {
+		fail(err)
+	}
+	defer out.Close()
+
+	if err := data.EncodeCatalog(out, problems); err != nil {
+		fail(err)
+	}
+
+	fmt.Printf("compiled %d problems into problems.gob\n", len(problems))
+
+	catalog := data.NewCatalog(problems)
+	table := similar.Precompute(catalog, similar.Build(catalog))
+
+	simOut, err := os.Create("similar.gob")
+	if err != nil {
+		fail(err)
+	}
+	defer simOut.Close()
+
+	if err := similar.EncodeTable(simOut, table); err != nil {
+		fail(err)
+	}
+
+	fmt.Printf("precomputed %d neighbors per problem into similar.gob\n", similar.TableSize)
+}
+
+// fetchExamples fills in the examples of every free problem without any,
+// pausing between requests to stay well under LeetCode's rate limits.
+func fetchExamples(problems []data.Problem) {
+	client := leetcode.NewClient()
+	added := 0
+	for i := range problems {
+		p := &problems[i]
+		if len(p.Examples) > 0 || p.PaidOnly {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		examples, err := client.Examples(ctx, p.TitleSlug)
+		cancel()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "gen: %s. %s: %v\n", p.ID, p.Title, err)
+		} else if len(examples) > 0 {
+			p.Examples = examples
+			added++
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+	fmt.Printf("fetched examples for %d problems\n", added)
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "gen:", err)
+	os.Exit(1)
+}
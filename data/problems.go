@@ -2,5 +2,17 @@ package data
 
 import _ "embed"
 
+//go:generate go run ./gen
+
 //go:embed problems.json
-var EmbeddedProblems []byte
\ No newline at end of file
+var EmbeddedProblems []byte
+
+// EmbeddedCatalog is problems.json precompiled to gob by ./gen.
+//
+//go:embed problems.gob
+var EmbeddedCatalog []byte
+
+// EmbeddedSimilar is the offline similarity table precomputed by ./gen.
+//
+//go:embed similar.gob
+var EmbeddedSimilar []byte
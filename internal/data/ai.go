@@ -4,4 +4,5 @@ type AISuggestion struct {
 	Title  string   `json:"title"`
 	Number int      `json:"number"`
 	Topics []string `json:"topics"`
+	Reason string   `json:"reason"` // why the model picked it, e.g. "targets weak topic Graph"
 }
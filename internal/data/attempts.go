@@ -0,0 +1,73 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Attempt is one recorded try at a problem (e.g. a local test run).
+type Attempt struct {
+	ID         string    `json:"id"`
+	At         time.Time `json:"at"`
+	Source     string    `json:"source"` // where it came from: "test", ...
+	Passed     bool      `json:"passed"`
+	Cases      int       `json:"cases,omitempty"`
+	Failed     int       `json:"failed,omitempty"`
+	DurationMs int64     `json:"durationMs,omitempty"`
+	Hints      int       `json:"hints,omitempty"` // highest hint level used before this attempt
+
+	SolveSeconds int64 `json:"solveSeconds,omitempty"` // time spent on the problem, when known
+}
+
+func (s *Store) AttemptsPathInit() string {
+	if s.AttemptsPath == "" {
+		s.AttemptsPath = filepath.Join(DataDir, "attempts.json")
+	}
+	return s.AttemptsPath
+}
+
+// LoadAttempts returns the solve log (oldest first), creating it if missing.
+func (s *Store) LoadAttempts() ([]Attempt, error) {
+	aPath := s.AttemptsPathInit()
+
+	if _, err := os.Stat(aPath); os.IsNotExist(err) {
+		if err := os.WriteFile(aPath, []byte("[]"), 0644); err != nil {
+			return nil, err
+		}
+	}
+
+	raw, err := os.ReadFile(aPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 {
+		return []Attempt{}, nil
+	}
+
+	var attempts []Attempt
+	if err := json.Unmarshal(raw, &attempts); err != nil {
+		return nil, fmt.Errorf("attempts.json is invalid; delete or fix the file: %v", err)
+	}
+	return attempts, nil
+}
+
+// SaveAttempts writes the solve log to disk (overwrites).
+func (s *Store) SaveAttempts(attempts []Attempt) error {
+	out, err := json.MarshalIndent(attempts, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.AttemptsPathInit(), out, 0644)
+}
+
+// AppendAttempt adds an attempt to the solve log.
+func (s *Store) AppendAttempt(a Attempt) error {
+	attempts, err := s.LoadAttempts()
+	if err != nil {
+		return err
+	}
+	return s.SaveAttempts(append(attempts, a))
+}
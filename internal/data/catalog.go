@@ -0,0 +1,106 @@
+package data
+
+import (
+	"sort"
+	"strings"
+)
+
+// Catalog is the in-memory problem database, indexed for lookups.
+// Problems keeps the original file order.
+type Catalog struct {
+	Problems []Problem
+
+	byID    map[string]int
+	bySlug  map[string]int
+	byTopic map[string][]int // lower-cased topic name -> problem indexes
+}
+
+func NewCatalog(problems []Problem) *Catalog {
+	c := &Catalog{
+		Problems: problems,
+		byID:     make(map[string]int, len(problems)),
+		bySlug:   make(map[string]int, len(problems)),
+		byTopic:  map[string][]int{},
+	}
+
+	for i, p := range problems {
+		c.byID[p.ID] = i
+		if p.TitleSlug != "" {
+			c.bySlug[p.TitleSlug] = i
+		}
+		for _, t := range p.TopicTags {
+			key := strings.ToLower(t.Name)
+			c.byTopic[key] = append(c.byTopic[key], i)
+		}
+	}
+
+	return c
+}
+
+// Len returns the number of problems in the catalog.
+func (c *Catalog) Len() int {
+	return len(c.Problems)
+}
+
+// ByID finds a problem by its frontend ID (e.g. "1").
+func (c *Catalog) ByID(id string) (Problem, bool) {
+	i, ok := c.byID[id]
+	if !ok {
+		return Problem{}, false
+	}
+	return c.Problems[i], true
+}
+
+// BySlug finds a problem by its title slug (e.g. "two-sum").
+func (c *Catalog) BySlug(slug string) (Problem, bool) {
+	i, ok := c.bySlug[slug]
+	if !ok {
+		return Problem{}, false
+	}
+	return c.Problems[i], true
+}
+
+// ByTopic returns all problems tagged with topic (case-insensitive).
+func (c *Catalog) ByTopic(topic string) []Problem {
+	idx := c.byTopic[strings.ToLower(topic)]
+	out := make([]Problem, 0, len(idx))
+	for _, i := range idx {
+		out = append(out, c.Problems[i])
+	}
+	return out
+}
+
+// Has reports whether any problem satisfies pred, e.g. whether the
+// catalog carries a piece of optional metadata at all.
+func (c *Catalog) Has(pred func(Problem) bool) bool {
+	for _, p := range c.Problems {
+		if pred(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// Lookup resolves either an ID or a slug.
+func (c *Catalog) Lookup(key string) (Problem, bool) {
+	if p, ok := c.ByID(key); ok {
+		return p, true
+	}
+	return c.BySlug(key)
+}
+
+// Topics returns every topic name in the catalog, sorted.
+func (c *Catalog) Topics() []string {
+	seen := map[string]bool{}
+	topics := []string{}
+	for _, p := range c.Problems {
+		for _, t := range p.TopicTags {
+			if !seen[t.Name] {
+				seen[t.Name] = true
+				topics = append(topics, t.Name)
+			}
+		}
+	}
+	sort.Strings(topics)
+	return topics
+}
@@ -0,0 +1,89 @@
+package data
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"os"
+)
+
+// catalogFormat is bumped whenever the gob layout changes, so stale
+// caches are rebuilt instead of half-decoded.
+const catalogFormat = 2
+
+// compiledCatalog is the on-disk/embedded binary form of problems.json.
+// SourceSize and SourceModTime describe the JSON file it was built from.
+type compiledCatalog struct {
+	Format        int
+	SourceSize    int64
+	SourceModTime int64
+	Problems      []Problem
+}
+
+// EncodeCatalog writes problems in the compiled gob form.
+func EncodeCatalog(w io.Writer, problems []Problem) error {
+	return gob.NewEncoder(w).Encode(compiledCatalog{
+		Format:   catalogFormat,
+		Problems: problems,
+	})
+}
+
+// DecodeCatalog reads a catalog written by EncodeCatalog.
+func DecodeCatalog(r io.Reader) (*Catalog, error) {
+	c, err := decodeCompiled(r)
+	if err != nil {
+		return nil, err
+	}
+	return NewCatalog(c.Problems), nil
+}
+
+func decodeCompiled(r io.Reader) (compiledCatalog, error) {
+	var c compiledCatalog
+	if err := gob.NewDecoder(r).Decode(&c); err != nil {
+		return c, err
+	}
+	if c.Format != catalogFormat {
+		return c, fmt.Errorf("compiled catalog format %d, want %d", c.Format, catalogFormat)
+	}
+	return c, nil
+}
+
+// loadCatalogCache returns the cached catalog if it was built from the
+// problems.json described by info.
+func (s *Store) loadCatalogCache(info os.FileInfo) (*Catalog, bool) {
+	if s.CatalogCachePath == "" {
+		return nil, false
+	}
+	raw, err := os.ReadFile(s.CatalogCachePath)
+	if err != nil {
+		return nil, false
+	}
+	c, err := decodeCompiled(bytes.NewReader(raw))
+	if err != nil {
+		return nil, false
+	}
+	if c.SourceSize != info.Size() || c.SourceModTime != info.ModTime().UnixNano() {
+		return nil, false
+	}
+	return NewCatalog(c.Problems), true
+}
+
+// saveCatalogCache writes the compiled catalog next to problems.json.
+// Failures are ignored: the cache only speeds up the next start.
+func (s *Store) saveCatalogCache(info os.FileInfo, problems []Problem) {
+	if s.CatalogCachePath == "" {
+		return
+	}
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(compiledCatalog{
+		Format:        catalogFormat,
+		SourceSize:    info.Size(),
+		SourceModTime: info.ModTime().UnixNano(),
+		Problems:      problems,
+	})
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(s.CatalogCachePath, buf.Bytes(), 0644)
+}
@@ -0,0 +1,53 @@
+package data
+
+import (
+	"bytes"
+	"testing"
+
+	assets "github.com/chhand2808/goleet/data"
+)
+
+func embeddedCatalog(tb testing.TB) *Catalog {
+	tb.Helper()
+	c, err := DecodeCatalog(bytes.NewReader(assets.EmbeddedCatalog))
+	if err != nil {
+		tb.Fatalf("decode embedded catalog: %v", err)
+	}
+	return c
+}
+
+// lookupIDs spreads lookups over the whole catalog, including a miss.
+func lookupIDs(c *Catalog) []string {
+	ids := []string{}
+	for i := 0; i < len(c.Problems); i += len(c.Problems) / 16 {
+		ids = append(ids, c.Problems[i].ID)
+	}
+	return append(ids, "no-such-id")
+}
+
+func BenchmarkCatalogByID(b *testing.B) {
+	c := embeddedCatalog(b)
+	ids := lookupIDs(c)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, id := range ids {
+			c.ByID(id)
+		}
+	}
+}
+
+// BenchmarkLinearScanByID is the lookup the index replaced.
+func BenchmarkLinearScanByID(b *testing.B) {
+	c := embeddedCatalog(b)
+	ids := lookupIDs(c)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, id := range ids {
+			for _, p := range c.Problems {
+				if p.ID == id {
+					break
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Chat roles, as the Gemini API names them.
+const (
+	ChatUser  = "user"
+	ChatModel = "model"
+)
+
+// ChatMessage is one turn of a tutor conversation about a problem.
+type ChatMessage struct {
+	Role string    `json:"role"`
+	Text string    `json:"text"`
+	At   time.Time `json:"at"`
+}
+
+// ChatPath is where a problem's tutor transcript is kept.
+func (s *Store) ChatPath(id string) string {
+	return filepath.Join(s.NotesDir(id), "chat.json")
+}
+
+// LoadChat returns a problem's transcript, oldest first (empty if none).
+func (s *Store) LoadChat(id string) ([]ChatMessage, error) {
+	raw, err := os.ReadFile(s.ChatPath(id))
+	if os.IsNotExist(err) || len(raw) == 0 {
+		return []ChatMessage{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []ChatMessage
+	if err := json.Unmarshal(raw, &msgs); err != nil {
+		return nil, fmt.Errorf("%s is invalid; delete it to start over: %v", s.ChatPath(id), err)
+	}
+	return msgs, nil
+}
+
+// SaveChat writes a problem's transcript (overwrites).
+func (s *Store) SaveChat(id string, msgs []ChatMessage) error {
+	if err := os.MkdirAll(s.NotesDir(id), 0755); err != nil {
+		return err
+	}
+	out, err := json.MarshalIndent(msgs, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.ChatPath(id), out, 0644)
+}
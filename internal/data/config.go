@@ -0,0 +1,89 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Config is data/config.json. Only api_key is required; everything else
+// falls back to a default when zero.
+type Config struct {
+	APIKey         string `json:"api_key"`
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty"` // deadline for one AI call, retries included
+	MaxRetries     int    `json:"max_retries,omitempty"`     // retries for overloaded / rate limited responses
+	CacheTTLHours  int    `json:"cache_ttl_hours,omitempty"` // how long identical AI requests are answered from cache
+
+	MonthlyBudgetUSD float64 `json:"monthly_budget_usd,omitempty"` // estimated AI spend per month; 0 = unlimited
+}
+
+const (
+	defaultAITimeout  = 60 * time.Second
+	defaultMaxRetries = 4
+	defaultCacheTTL   = 24 * time.Hour
+)
+
+// Timeout returns the configured AI deadline or the default.
+func (c Config) Timeout() time.Duration {
+	if c.TimeoutSeconds <= 0 {
+		return defaultAITimeout
+	}
+	return time.Duration(c.TimeoutSeconds) * time.Second
+}
+
+// Retries returns the configured retry count or the default.
+func (c Config) Retries() int {
+	if c.MaxRetries <= 0 {
+		return defaultMaxRetries
+	}
+	return c.MaxRetries
+}
+
+// CacheTTL returns the configured AI cache lifetime or the default.
+func (c Config) CacheTTL() time.Duration {
+	if c.CacheTTLHours <= 0 {
+		return defaultCacheTTL
+	}
+	return time.Duration(c.CacheTTLHours) * time.Hour
+}
+
+// CacheDir is where AI responses are cached.
+func (s *Store) CacheDir() string {
+	return filepath.Join(filepath.Dir(s.ConfigPathInit()), "cache", "ai")
+}
+
+func (s *Store) ConfigPathInit() string {
+	if s.ConfigPath == "" {
+		s.ConfigPath = filepath.Join(DataDir, "config.json")
+	}
+	return s.ConfigPath
+}
+
+// LoadConfig reads config.json. A missing file yields an empty config.
+func (s *Store) LoadConfig() (Config, error) {
+	var cfg Config
+
+	raw, err := os.ReadFile(s.ConfigPathInit())
+	if os.IsNotExist(err) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, err
+	}
+
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return cfg, fmt.Errorf("config.json is invalid; run `goleet init` again: %v", err)
+	}
+	return cfg, nil
+}
+
+// SaveConfig writes config.json (overwrites).
+func (s *Store) SaveConfig(cfg Config) error {
+	out, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.ConfigPathInit(), out, 0644)
+}
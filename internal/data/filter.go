@@ -0,0 +1,73 @@
+package data
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Filter narrows down which catalog problems may be suggested.
+// Zero values mean "no restriction".
+type Filter struct {
+	Difficulty    string  // Easy / Medium / Hard
+	Topic         string  // topic tag name, case-insensitive
+	FreeOnly      bool    // exclude paid-only problems
+	MinAcceptance float64 // minimum acceptance rate in percent
+}
+
+// Match reports whether p satisfies every restriction of the filter.
+// MinAcceptance only filters problems whose acceptance rate is known.
+func (f Filter) Match(p Problem) bool {
+	if f.Difficulty != "" && !strings.EqualFold(p.Difficulty, f.Difficulty) {
+		return false
+	}
+	if f.FreeOnly && p.PaidOnly {
+		return false
+	}
+	if f.MinAcceptance > 0 && p.AcRate > 0 && p.AcRate < f.MinAcceptance {
+		return false
+	}
+	if f.Topic != "" {
+		found := false
+		for _, t := range p.TopicTags {
+			if strings.EqualFold(t.Name, f.Topic) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Unsupported lists restrictions the catalog has no metadata for, so they
+// cannot narrow anything down.
+func (f Filter) Unsupported(c *Catalog) []string {
+	var out []string
+	if f.FreeOnly && !c.Has(func(p Problem) bool { return p.PaidOnly }) {
+		out = append(out, "--free-only (no paid-only flags)")
+	}
+	if f.MinAcceptance > 0 && !c.Has(func(p Problem) bool { return p.AcRate > 0 }) {
+		out = append(out, "--min-acceptance (no acceptance rates)")
+	}
+	return out
+}
+
+// Describe returns a short human readable summary, or "" when the filter is empty.
+func (f Filter) Describe() string {
+	parts := []string{}
+	if f.Difficulty != "" {
+		parts = append(parts, "difficulty="+f.Difficulty)
+	}
+	if f.Topic != "" {
+		parts = append(parts, "topic="+f.Topic)
+	}
+	if f.FreeOnly {
+		parts = append(parts, "free problems only (no paid-only)")
+	}
+	if f.MinAcceptance > 0 {
+		parts = append(parts, fmt.Sprintf("acceptance rate >= %.1f%%", f.MinAcceptance))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,121 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Hint levels, from gentle to almost-solution.
+const (
+	HintNudge    = 1
+	HintApproach = 2
+	HintSketch   = 3
+	MaxHintLevel = HintSketch
+)
+
+// HintLevelName describes a hint level.
+func HintLevelName(level int) string {
+	switch level {
+	case HintNudge:
+		return "nudge"
+	case HintApproach:
+		return "approach"
+	case HintSketch:
+		return "algorithm sketch"
+	}
+	return fmt.Sprintf("level %d", level)
+}
+
+// HintRecord is a hint that was revealed for a problem.
+type HintRecord struct {
+	ID    string `json:"id"`
+	Level int    `json:"level"`
+	Text  string `json:"text"`
+	Date  string `json:"date"` // YYYY-MM-DD
+}
+
+func (s *Store) HintsPathInit() string {
+	if s.HintsPath == "" {
+		s.HintsPath = filepath.Join(DataDir, "hints.json")
+	}
+	return s.HintsPath
+}
+
+// LoadHints returns every revealed hint, creating the file if missing.
+func (s *Store) LoadHints() ([]HintRecord, error) {
+	hPath := s.HintsPathInit()
+
+	if _, err := os.Stat(hPath); os.IsNotExist(err) {
+		if err := os.WriteFile(hPath, []byte("[]"), 0644); err != nil {
+			return nil, err
+		}
+	}
+
+	raw, err := os.ReadFile(hPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 {
+		return []HintRecord{}, nil
+	}
+
+	var hints []HintRecord
+	if err := json.Unmarshal(raw, &hints); err != nil {
+		return nil, fmt.Errorf("hints.json is invalid; delete or fix the file: %v", err)
+	}
+	return hints, nil
+}
+
+// SaveHints writes the hints to disk (overwrites).
+func (s *Store) SaveHints(hints []HintRecord) error {
+	out, err := json.MarshalIndent(hints, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.HintsPathInit(), out, 0644)
+}
+
+// HintsFor returns the hints revealed for a problem, indexed by level.
+func (s *Store) HintsFor(id string) (map[int]HintRecord, error) {
+	hints, err := s.LoadHints()
+	if err != nil {
+		return nil, err
+	}
+	out := map[int]HintRecord{}
+	for _, h := range hints {
+		if h.ID == id {
+			out[h.Level] = h
+		}
+	}
+	return out, nil
+}
+
+// SaveHint records (or replaces) the hint of a level for a problem.
+func (s *Store) SaveHint(rec HintRecord) error {
+	hints, err := s.LoadHints()
+	if err != nil {
+		return err
+	}
+	for i, h := range hints {
+		if h.ID == rec.ID && h.Level == rec.Level {
+			hints[i] = rec
+			return s.SaveHints(hints)
+		}
+	}
+	return s.SaveHints(append(hints, rec))
+}
+
+// HintLevel returns the highest hint level used for a problem (0 = unaided).
+func (s *Store) HintLevel(id string) int {
+	hints, err := s.HintsFor(id)
+	if err != nil {
+		return 0
+	}
+	level := 0
+	for l := range hints {
+		level = max(level, l)
+	}
+	return level
+}
@@ -9,14 +9,82 @@ import (
 )
 
 type HistoryEntry struct {
-	ID    string `json:"id"`
-	Title string `json:"title"`
-	Date  string `json:"date"` // YYYY-MM-DD
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Date   string `json:"date"`             // YYYY-MM-DD
+	Status string `json:"status,omitempty"` // StatusSuggested (default), StatusOffered, ...
+	Reason string `json:"reason,omitempty"` // why the AI proposed it
+
+	SkipReason   string `json:"skipReason,omitempty"`   // SkipTooHard, SkipSeenIt, SkipNotInterested
+	SnoozedUntil string `json:"snoozedUntil,omitempty"` // YYYY-MM-DD, excluded until then
+}
+
+const (
+	// StatusSuggested marks the problem the user went with.
+	StatusSuggested = "suggested"
+	// StatusOffered marks AI suggestions that were shown but not chosen.
+	StatusOffered = "offered"
+	// StatusSkipped marks suggestions the user rejected with `goleet skip`.
+	StatusSkipped = "skipped"
+	// StatusSnoozed marks problems snoozed without having been suggested.
+	StatusSnoozed = "snoozed"
+)
+
+// Reasons accepted by `goleet skip --reason`.
+const (
+	SkipTooHard       = "too-hard"
+	SkipSeenIt        = "seen-it"
+	SkipNotInterested = "not-interested"
+)
+
+// SkipReasons lists the valid skip reasons.
+var SkipReasons = []string{SkipTooHard, SkipSeenIt, SkipNotInterested}
+
+// IsOffered reports whether the entry was only offered, not chosen.
+func (h HistoryEntry) IsOffered() bool {
+	return h.Status == StatusOffered
+}
+
+// IsSnoozed reports whether the entry is snoozed on the given day (YYYY-MM-DD).
+func (h HistoryEntry) IsSnoozed(today string) bool {
+	return h.SnoozedUntil != "" && h.SnoozedUntil > today
+}
+
+// Blocks reports whether the entry keeps its problem from being suggested
+// on the given day. Recent suggestions are avoided, but an expired snooze
+// makes the problem available again.
+func (h HistoryEntry) Blocks(today string) bool {
+	if h.SnoozedUntil != "" {
+		return h.IsSnoozed(today)
+	}
+	return true
+}
+
+// sticky entries survive history trimming: dropping them would lose an
+// active snooze or a "seen it" rejection.
+func (h HistoryEntry) sticky(today string) bool {
+	return h.IsSnoozed(today) || h.SkipReason == SkipSeenIt
+}
+
+// BlockedIDs returns the problem IDs history excludes from suggestions today.
+// The newest entry for an ID decides.
+func BlockedIDs(hist []HistoryEntry, now time.Time) map[string]bool {
+	today := now.Format("2006-01-02")
+	block := map[string]bool{}
+	for _, h := range hist {
+		block[h.ID] = h.Blocks(today) || h.SkipReason == SkipSeenIt
+	}
+	for id, blocked := range block {
+		if !blocked {
+			delete(block, id)
+		}
+	}
+	return block
 }
 
 func (s *Store) HistoryPathInit() string {
 	if s.HistoryPath == "" {
-		s.HistoryPath = filepath.Join("data", "history.json")
+		s.HistoryPath = filepath.Join(DataDir, "history.json")
 	}
 	return s.HistoryPath
 }
@@ -75,14 +143,45 @@ func (s *Store) AppendHistory(entry HistoryEntry, maxLen int) error {
 	// Append entry
 	hist = append(hist, entry)
 
-	// Enforce max length
-	if len(hist) > maxLen {
-		// remove oldest entries from the front
-		start := len(hist) - maxLen
-		hist = hist[start:]
+	return s.SaveHistory(trimHistory(hist, maxLen))
+}
+
+// trimHistory removes the oldest entries until at most maxLen remain,
+// keeping sticky ones (which may leave the slice slightly longer).
+func trimHistory(hist []HistoryEntry, maxLen int) []HistoryEntry {
+	excess := len(hist) - maxLen
+	if excess <= 0 {
+		return hist
+	}
+
+	today := time.Now().Format("2006-01-02")
+	out := make([]HistoryEntry, 0, maxLen)
+	for _, h := range hist {
+		if excess > 0 && !h.sticky(today) {
+			excess--
+			continue
+		}
+		out = append(out, h)
+	}
+	return out
+}
+
+// UpdateHistory applies fn to the most recent entry for id (or, with id "",
+// to the most recent non-offered entry) and saves. It reports false when no
+// entry matched.
+func (s *Store) UpdateHistory(id string, fn func(*HistoryEntry)) (bool, error) {
+	hist, err := s.LoadHistory()
+	if err != nil && hist == nil {
+		return false, err
 	}
 
-	return s.SaveHistory(hist)
+	for i := len(hist) - 1; i >= 0; i-- {
+		if (id == "" && !hist[i].IsOffered()) || (id != "" && hist[i].ID == id) {
+			fn(&hist[i])
+			return true, s.SaveHistory(hist)
+		}
+	}
+	return false, nil
 }
 
 // Helper: create a HistoryEntry for a problem; date is set to today if empty
@@ -0,0 +1,36 @@
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// NotesDir returns the directory holding a problem's notes, reviews and
+// chat transcripts (data/notes/<id>).
+func (s *Store) NotesDir(id string) string {
+	if s.NotesRoot == "" {
+		s.NotesRoot = filepath.Join(DataDir, "notes")
+	}
+	return filepath.Join(s.NotesRoot, id)
+}
+
+// LoadNotes returns the user's free-form notes for a problem ("" if none).
+func (s *Store) LoadNotes(id string) (string, error) {
+	raw, err := os.ReadFile(filepath.Join(s.NotesDir(id), "notes.md"))
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	return string(raw), err
+}
+
+// SaveReview stores an AI code review next to the problem's notes and
+// returns its path.
+func (s *Store) SaveReview(id string, at time.Time, markdown string) (string, error) {
+	dir := s.NotesDir(id)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, "review-"+at.Format("20060102-150405")+".md")
+	return path, os.WriteFile(path, []byte(markdown), 0644)
+}
@@ -0,0 +1,62 @@
+package data
+
+import "fmt"
+
+type TopicTag struct {
+	Name string `json:"name"`
+}
+
+type Problem struct {
+	ID         string     `json:"frontendQuestionId"`
+	Title      string     `json:"title"`
+	Difficulty string     `json:"difficulty"`
+	TitleSlug  string     `json:"titleSlug"`
+	TopicTags  []TopicTag `json:"topicTags"`
+
+	// Optional catalog metadata; zero when the catalog doesn't provide it.
+	AcRate           float64           `json:"acRate,omitempty"`   // acceptance rate in percent (0-100)
+	PaidOnly         bool              `json:"paidOnly,omitempty"` // premium-only problem
+	Frequency        float64           `json:"freqBar,omitempty"`  // interview frequency (0-100)
+	Likes            int               `json:"likes,omitempty"`
+	Dislikes         int               `json:"dislikes,omitempty"`
+	SimilarQuestions []SimilarQuestion `json:"similarQuestions,omitempty"`
+	Hints            []string          `json:"hints,omitempty"`
+	Examples         []Example         `json:"examples,omitempty"`
+	Content          string            `json:"content,omitempty"` // statement HTML as served by leetcode.com
+}
+
+// Example is one sample case from the problem statement, as shown on
+// leetcode.com (e.g. Input "nums = [2,7,11,15], target = 9", Output "[0,1]").
+type Example struct {
+	Input  string `json:"input"`
+	Output string `json:"output"`
+}
+
+type SimilarQuestion struct {
+	Title      string `json:"title"`
+	TitleSlug  string `json:"titleSlug"`
+	Difficulty string `json:"difficulty"`
+}
+
+// Link returns the leetcode.com URL of the problem.
+func (p Problem) Link() string {
+	return fmt.Sprintf("https://leetcode.com/problems/%s/", p.TitleSlug)
+}
+
+// LikeRatio returns likes / (likes + dislikes), or 0 when unknown.
+func (p Problem) LikeRatio() float64 {
+	total := p.Likes + p.Dislikes
+	if total == 0 {
+		return 0
+	}
+	return float64(p.Likes) / float64(total)
+}
+
+// Topics returns the topic tag names of the problem.
+func (p Problem) Topics() []string {
+	names := make([]string, 0, len(p.TopicTags))
+	for _, t := range p.TopicTags {
+		names = append(names, t.Name)
+	}
+	return names
+}
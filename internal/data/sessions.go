@@ -0,0 +1,98 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Session kinds.
+const (
+	SessionMock    = "mock"
+	SessionContest = "contest"
+)
+
+// Problem outcomes within a session.
+const (
+	OutcomeSolved     = "solved"
+	OutcomeGaveUp     = "gave-up"
+	OutcomeUnfinished = "unfinished" // time ran out or the session was quit
+)
+
+// Session is a timed problem set, kept apart from casual practice.
+type Session struct {
+	Kind        string           `json:"kind"`
+	Start       time.Time        `json:"start"`
+	DurationSec int64            `json:"durationSec"` // time allowed
+	ElapsedSec  int64            `json:"elapsedSec"`  // time used
+	Problems    []SessionProblem `json:"problems"`
+	Score       float64          `json:"score"`
+	MaxScore    float64          `json:"maxScore"`
+
+	// contests only
+	PenaltySec   int64   `json:"penaltySec,omitempty"` // finish time incl. wrong-attempt penalties
+	Rating       float64 `json:"rating,omitempty"`     // contest rating after this session
+	RatingChange float64 `json:"ratingChange,omitempty"`
+}
+
+// SessionProblem is how one problem of a session went.
+type SessionProblem struct {
+	ID         string  `json:"id"`
+	Title      string  `json:"title"`
+	Difficulty string  `json:"difficulty"`
+	Outcome    string  `json:"outcome,omitempty"` // "" = never reached
+	Seconds    int64   `json:"seconds,omitempty"` // time spent on it
+	Hints      int     `json:"hints,omitempty"`   // hint levels revealed during the session
+	Wrong      int     `json:"wrong,omitempty"`   // failed submissions before the solve (contests)
+	Points     float64 `json:"points"`
+}
+
+// Solved counts the problems solved in the session.
+func (s Session) Solved() int {
+	n := 0
+	for _, p := range s.Problems {
+		if p.Outcome == OutcomeSolved {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *Store) SessionsPathInit() string {
+	if s.SessionsPath == "" {
+		s.SessionsPath = filepath.Join(DataDir, "sessions.json")
+	}
+	return s.SessionsPath
+}
+
+// LoadSessions returns every recorded session, oldest first.
+func (s *Store) LoadSessions() ([]Session, error) {
+	raw, err := os.ReadFile(s.SessionsPathInit())
+	if os.IsNotExist(err) || len(raw) == 0 {
+		return []Session{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var sessions []Session
+	if err := json.Unmarshal(raw, &sessions); err != nil {
+		return nil, fmt.Errorf("sessions.json is invalid; delete or fix the file: %v", err)
+	}
+	return sessions, nil
+}
+
+// AppendSession records a finished session.
+func (s *Store) AppendSession(session Session) error {
+	sessions, err := s.LoadSessions()
+	if err != nil {
+		return err
+	}
+	out, err := json.MarshalIndent(append(sessions, session), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.SessionsPathInit(), out, 0644)
+}
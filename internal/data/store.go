@@ -1,45 +1,83 @@
 package data
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"time"
-)
 
-type Problem struct {
-	ID         string `json:"frontendQuestionId"`
-	Title      string `json:"title"`
-	Difficulty string `json:"difficulty"`
-	TitleSlug  string `json:"titleSlug"`
-	TopicTags  []struct {
-		Name string `json:"name"`
-	} `json:"topicTags"`
-}
+	assets "github.com/chhand2808/goleet/data"
+)
 
 type SolvedProblem struct {
 	ID    string `json:"id"`
 	Title string `json:"title"`
 	Date  string `json:"date"`
+	Hints int    `json:"hints,omitempty"` // highest hint level used, 0 = unaided
 }
 
 type Store struct {
-	ProblemsPath string
-	SolvedPath   string
-	HistoryPath  string
+	ProblemsPath     string
+	CatalogCachePath string
+	SolvedPath       string
+	HistoryPath      string
+	AttemptsPath     string
+	ConfigPath       string
+	HintsPath        string
+	NotesRoot        string
+	UsagePath        string
+	SessionsPath     string
+	TeamPath         string
 }
 
+// DataDir is where GoLeet keeps its files, relative to the working directory.
+const DataDir = "data"
+
 func NewStore() *Store {
+	return NewStoreAt(DataDir)
+}
+
+// NewStoreAt returns a store over the data directory dir, e.g. a
+// teammate's copy of their data.
+func NewStoreAt(dir string) *Store {
 	return &Store{
-		ProblemsPath: filepath.Join("data", "problems.json"),
-		SolvedPath:   filepath.Join("data", "solved.json"),
-		HistoryPath:  filepath.Join("data", "history.json"),
+		ProblemsPath:     filepath.Join(dir, "problems.json"),
+		CatalogCachePath: filepath.Join(dir, "problems.cache.gob"),
+		SolvedPath:       filepath.Join(dir, "solved.json"),
+		HistoryPath:      filepath.Join(dir, "history.json"),
+		AttemptsPath:     filepath.Join(dir, "attempts.json"),
+		ConfigPath:       filepath.Join(dir, "config.json"),
+		HintsPath:        filepath.Join(dir, "hints.json"),
+		NotesRoot:        filepath.Join(dir, "notes"),
+		UsagePath:        filepath.Join(dir, "usage.json"),
+		SessionsPath:     filepath.Join(dir, "sessions.json"),
+		TeamPath:         filepath.Join(dir, "team.json"),
 	}
 }
 
-// Load all problems
-func (s *Store) LoadProblems() ([]Problem, error) {
+// TemplatesDir holds per-user template overrides (scaffold languages, prompts).
+func (s *Store) TemplatesDir() string {
+	return filepath.Join(filepath.Dir(s.ConfigPathInit()), "templates")
+}
+
+// LoadCatalog loads problems.json into an indexed catalog.
+// A compiled gob copy is cached next to the JSON and reused while the JSON
+// is unchanged; without a local problems.json the embedded catalog is used.
+func (s *Store) LoadCatalog() (*Catalog, error) {
+	info, err := os.Stat(s.ProblemsPath)
+	if os.IsNotExist(err) {
+		return DecodeCatalog(bytes.NewReader(assets.EmbeddedCatalog))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if c, ok := s.loadCatalogCache(info); ok {
+		return c, nil
+	}
+
 	file, err := os.ReadFile(s.ProblemsPath)
 	if err != nil {
 		return nil, err
@@ -48,10 +86,11 @@ func (s *Store) LoadProblems() ([]Problem, error) {
 	var problems []Problem
 	err = json.Unmarshal(file, &problems)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("problems.json is invalid; delete it and run `goleet init` again: %v", err)
 	}
 
-	return problems, nil
+	s.saveCatalogCache(info, problems)
+	return NewCatalog(problems), nil
 }
 
 // Load solved problems
@@ -102,7 +141,7 @@ func (s *Store) SaveSolved(solved []SolvedProblem) error {
 	return os.WriteFile(s.SolvedPath, data, 0644)
 }
 
-// Mark a problem as solved (adds or updates date)
+// Mark a problem as solved (adds or updates date and hint usage)
 func (s *Store) MarkSolved(problemID, title string) error {
 	solved, err := s.LoadSolved()
 	if err != nil {
@@ -110,11 +149,13 @@ func (s *Store) MarkSolved(problemID, title string) error {
 	}
 
 	date := time.Now().Format("2006-01-02")
+	hints := s.HintLevel(problemID)
 	found := false
 
 	for i, p := range solved {
 		if p.ID == problemID {
 			solved[i].Date = date
+			solved[i].Hints = hints
 			found = true
 			break
 		}
@@ -125,6 +166,7 @@ func (s *Store) MarkSolved(problemID, title string) error {
 			ID:    problemID,
 			Title: title,
 			Date:  date,
+			Hints: hints,
 		})
 	}
 
@@ -0,0 +1,52 @@
+package data
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// benchStore writes the embedded catalog as problems.json into a temp dir.
+func benchStore(b *testing.B, cached bool) *Store {
+	b.Helper()
+	dir := b.TempDir()
+	raw, err := json.Marshal(embeddedCatalog(b).Problems)
+	if err != nil {
+		b.Fatal(err)
+	}
+	store := NewStoreAt(dir)
+	if err := os.WriteFile(store.ProblemsPath, raw, 0644); err != nil {
+		b.Fatal(err)
+	}
+	if !cached {
+		store.CatalogCachePath = "" // parse the JSON every time
+	}
+	return store
+}
+
+func BenchmarkLoadCatalogJSON(b *testing.B) {
+	store := benchStore(b, false)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := store.LoadCatalog(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLoadCatalogGob(b *testing.B) {
+	store := benchStore(b, true)
+	if _, err := store.LoadCatalog(); err != nil { // writes the cache
+		b.Fatal(err)
+	}
+	if _, err := os.Stat(store.CatalogCachePath); err != nil {
+		b.Fatalf("no catalog cache at %s", filepath.Base(store.CatalogCachePath))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := store.LoadCatalog(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Team is data/team.json: a team sharing a daily problem and, optionally,
+// each member's data directory (e.g. checked out from a shared repo).
+type Team struct {
+	Name     string       `json:"name"`
+	Members  []TeamMember `json:"members,omitempty"`
+	Problems []string     `json:"problems,omitempty"` // shared daily list; empty = free catalog problems
+	Rotation []string     `json:"rotation,omitempty"` // daily difficulty, cycling; empty = default
+}
+
+// TeamMember is one teammate and the directory holding their solved.json.
+type TeamMember struct {
+	Name string `json:"name"`
+	Dir  string `json:"dir"` // relative to the working directory
+}
+
+// MemberName names a member after their data directory: "alice/data"
+// and "alice" both give "alice".
+func MemberName(dir string) string {
+	dir = filepath.Clean(dir)
+	if filepath.Base(dir) == DataDir && filepath.Dir(dir) != "." {
+		dir = filepath.Dir(dir)
+	}
+	return filepath.Base(dir)
+}
+
+// TeamFromDirs builds a team out of data directories given on the command line.
+func TeamFromDirs(dirs []string) Team {
+	team := Team{}
+	for _, d := range dirs {
+		if d = strings.TrimSpace(d); d != "" {
+			team.Members = append(team.Members, TeamMember{Name: MemberName(d), Dir: d})
+		}
+	}
+	return team
+}
+
+func (s *Store) TeamPathInit() string {
+	if s.TeamPath == "" {
+		s.TeamPath = filepath.Join(DataDir, "team.json")
+	}
+	return s.TeamPath
+}
+
+// LoadTeam reads team.json. A missing file yields an empty team.
+func (s *Store) LoadTeam() (Team, error) {
+	var team Team
+
+	raw, err := os.ReadFile(s.TeamPathInit())
+	if os.IsNotExist(err) {
+		return team, nil
+	}
+	if err != nil {
+		return team, err
+	}
+
+	if err := json.Unmarshal(raw, &team); err != nil {
+		return team, fmt.Errorf("team.json is invalid; delete or fix the file: %v", err)
+	}
+	for i, m := range team.Members {
+		if m.Name == "" {
+			team.Members[i].Name = MemberName(m.Dir)
+		}
+	}
+	return team, nil
+}
+
+// LoadMemberSolved reads a teammate's solved log without creating it.
+// ok is false when the directory has no solved.json.
+func LoadMemberSolved(dir string) (solved []SolvedProblem, ok bool, err error) {
+	store := NewStoreAt(dir)
+	if _, err := os.Stat(store.SolvedPath); err != nil {
+		return nil, false, nil
+	}
+	solved, err = store.LoadSolved()
+	return solved, err == nil, err
+}
@@ -0,0 +1,69 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// AIUsage is the token count and estimated cost of one AI call.
+type AIUsage struct {
+	At             time.Time `json:"at"`
+	Command        string    `json:"command"` // goleet command that made the call
+	Model          string    `json:"model"`
+	PromptTokens   int       `json:"promptTokens"`
+	ResponseTokens int       `json:"responseTokens"`
+	CostUSD        float64   `json:"costUsd"`
+}
+
+func (s *Store) UsagePathInit() string {
+	if s.UsagePath == "" {
+		s.UsagePath = filepath.Join(DataDir, "usage.json")
+	}
+	return s.UsagePath
+}
+
+// LoadUsage returns the AI usage log (oldest first). A missing file is empty.
+func (s *Store) LoadUsage() ([]AIUsage, error) {
+	raw, err := os.ReadFile(s.UsagePathInit())
+	if os.IsNotExist(err) || len(raw) == 0 {
+		return []AIUsage{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var usage []AIUsage
+	if err := json.Unmarshal(raw, &usage); err != nil {
+		return nil, fmt.Errorf("usage.json is invalid; delete or fix the file: %v", err)
+	}
+	return usage, nil
+}
+
+// AppendUsage adds one call to the usage log.
+func (s *Store) AppendUsage(u AIUsage) error {
+	usage, err := s.LoadUsage()
+	if err != nil {
+		return err
+	}
+	out, err := json.MarshalIndent(append(usage, u), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.UsagePathInit(), out, 0644)
+}
+
+// MonthCost sums the estimated cost of calls made in now's calendar month.
+func MonthCost(usage []AIUsage, now time.Time) float64 {
+	year, month, _ := now.Date()
+	total := 0.0
+	for _, u := range usage {
+		y, m, _ := u.At.In(now.Location()).Date()
+		if y == year && m == month {
+			total += u.CostUSD
+		}
+	}
+	return total
+}
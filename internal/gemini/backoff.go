@@ -0,0 +1,39 @@
+package gemini
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+const (
+	backoffBase = 1 * time.Second
+	backoffMax  = 30 * time.Second
+)
+
+// backoff returns how long to wait before retry number attempt (1-based):
+// exponential with jitter, or the server's Retry-After when it gave one.
+func backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return min(retryAfter, backoffMax)
+	}
+
+	wait := backoffBase << (attempt - 1)
+	if wait > backoffMax || wait <= 0 {
+		wait = backoffMax
+	}
+	// full jitter in [wait/2, wait)
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)))
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
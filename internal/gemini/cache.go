@@ -0,0 +1,75 @@
+package gemini
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Cache is a content-addressed store of parsed AI responses: identical
+// requests within TTL are answered from disk instead of the API.
+type Cache struct {
+	Dir string
+	TTL time.Duration
+}
+
+type cacheEntry struct {
+	Created time.Time       `json:"created"`
+	Value   json.RawMessage `json:"value"`
+}
+
+// cacheKey hashes everything that determines the answer.
+func cacheKey(model string, reqBody interface{}) (string, error) {
+	raw, err := json.Marshal(reqBody)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(append([]byte(model+"\n"), raw...))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.Dir, key+".json")
+}
+
+// Get decodes a fresh cached value into v and reports whether it did.
+func (c *Cache) Get(key string, v interface{}) bool {
+	if c == nil {
+		return false
+	}
+	raw, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return false
+	}
+	var e cacheEntry
+	if json.Unmarshal(raw, &e) != nil {
+		return false
+	}
+	if c.TTL > 0 && time.Since(e.Created) > c.TTL {
+		_ = os.Remove(c.path(key))
+		return false
+	}
+	return json.Unmarshal(e.Value, v) == nil
+}
+
+// Put stores v under key. Failures are ignored: the cache is best effort.
+func (c *Cache) Put(key string, v interface{}) {
+	if c == nil {
+		return
+	}
+	value, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+	raw, err := json.Marshal(cacheEntry{Created: time.Now(), Value: value})
+	if err != nil {
+		return
+	}
+	if os.MkdirAll(c.Dir, 0755) != nil {
+		return
+	}
+	_ = os.WriteFile(c.path(key), raw, 0644)
+}
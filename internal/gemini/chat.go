@@ -0,0 +1,82 @@
+package gemini
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+// maxChatMessages bounds how much of a long transcript is sent each turn.
+const maxChatMessages = 30
+
+const chatGuardrails = `You are a patient coding interview tutor helping the user with ONE
+LeetCode problem, described below. Teach; do not solve it for them.
+
+RULES:
+- Never give the full solution or complete code unless the user explicitly
+  asks for it (e.g. "show me the solution"). Short snippets of syntax are fine.
+- Prefer questions and small hints that let the user find the next step.
+- When the user shares an idea or code, point out what works and the first
+  thing that does not, with a counterexample if possible.
+- Stay on this problem and closely related concepts; politely decline other topics.
+- Keep answers short: a few sentences of plain text.`
+
+// BuildChatSystem renders the tutor's system instruction for a problem and
+// the user's own notes on it.
+func BuildChatSystem(p data.Problem, notes string) string {
+	var b strings.Builder
+
+	b.WriteString(chatGuardrails)
+	fmt.Fprintf(&b, "\n\nPROBLEM: %s. %s (%s)\n", p.ID, p.Title, p.Difficulty)
+	fmt.Fprintf(&b, "LINK: %s\n", p.Link())
+	if topics := p.Topics(); len(topics) > 0 {
+		fmt.Fprintf(&b, "TOPICS (do not reveal unless asked): %s\n", strings.Join(topics, ", "))
+	}
+	for i, ex := range p.Examples {
+		fmt.Fprintf(&b, "EXAMPLE %d: input %s -> output %s\n", i+1, ex.Input, ex.Output)
+	}
+	if len(p.Hints) > 0 {
+		b.WriteString("OFFICIAL HINTS (reveal gradually, only when the user is stuck):\n")
+		for _, h := range p.Hints {
+			fmt.Fprintf(&b, "- %s\n", h)
+		}
+	}
+	if notes = strings.TrimSpace(notes); notes != "" {
+		fmt.Fprintf(&b, "\nUSER'S NOTES ON THIS PROBLEM:\n%s\n", notes)
+	}
+
+	return b.String()
+}
+
+// Chat sends the conversation so far (ending with the user's latest
+// message) and returns the tutor's reply.
+func (c *Client) Chat(ctx context.Context, system string, msgs []data.ChatMessage) (string, error) {
+	if len(msgs) > maxChatMessages {
+		msgs = msgs[len(msgs)-maxChatMessages:]
+	}
+	// the API wants the conversation to open with a user turn
+	for len(msgs) > 0 && msgs[0].Role != data.ChatUser {
+		msgs = msgs[1:]
+	}
+
+	contents := make([]map[string]interface{}, 0, len(msgs))
+	for _, m := range msgs {
+		contents = append(contents, map[string]interface{}{
+			"role":  m.Role,
+			"parts": []map[string]string{{"text": m.Text}},
+		})
+	}
+
+	gResp, err := c.generate(ctx, map[string]interface{}{
+		"contents": contents,
+		"systemInstruction": map[string]interface{}{
+			"parts": []map[string]string{{"text": system}},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return firstText(gResp)
+}
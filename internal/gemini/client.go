@@ -2,12 +2,13 @@ package gemini
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"os"
+	"time"
 
 	"github.com/chhand2808/goleet/internal/data"
 )
@@ -15,12 +16,129 @@ import (
 // Use Gemini 2.0 Flash-Lite model
 const model = "models/gemini-2.0-flash-lite"
 
-func GetSuggestions(prompt string) ([]data.AISuggestion, error) {
-	apiKey := loadAPIKey()
-	if apiKey == "" {
-		return nil, errors.New("API key missing. Run `goleet init` again")
+const baseURL = "https://generativelanguage.googleapis.com/v1beta"
+
+// Client talks to the Gemini generateContent API.
+type Client struct {
+	APIKey     string
+	Model      string
+	BaseURL    string
+	HTTP       *http.Client
+	Timeout    time.Duration // deadline for a whole call, retries included
+	MaxRetries int
+	Cache      *Cache   // nil disables caching
+	Tools      *Toolbox // offered during GetSuggestions; nil = no tool calling
+
+	// OnRetry, if set, is called before waiting for the next attempt.
+	OnRetry func(attempt int, wait time.Duration, err error)
+	// OnUsage, if set, is called with the token counts of every answered request.
+	OnUsage func(u Usage)
+}
+
+// Usage is the token accounting of one answered request.
+type Usage struct {
+	Model          string
+	PromptTokens   int
+	ResponseTokens int
+	CostUSD        float64 // estimate, see EstimateCost
+}
+
+// NewClient builds a client from data/config.json.
+func NewClient(store *data.Store) (*Client, error) {
+	cfg, err := store.LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := &http.Client{}
+	if t := NewTransportFromEnv(); t != nil {
+		httpClient.Transport = t
+		if t.Replay && cfg.APIKey == "" {
+			cfg.APIKey = "replay" // fixtures never need a real key
+		}
+	}
+
+	if cfg.APIKey == "" {
+		return nil, ErrMissingAPIKey
+	}
+	if err := checkBudget(store, cfg); err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		APIKey:     cfg.APIKey,
+		Model:      model,
+		BaseURL:    baseURL,
+		HTTP:       httpClient,
+		Timeout:    cfg.Timeout(),
+		MaxRetries: cfg.Retries(),
+		Cache:      &Cache{Dir: store.CacheDir(), TTL: cfg.CacheTTL()},
+	}, nil
+}
+
+// GetSuggestions asks the model to rank candidates and returns its picks.
+// With Tools set, the model may first look things up with them.
+func (c *Client) GetSuggestions(ctx context.Context, prompt string, candidates []data.Problem) ([]data.AISuggestion, error) {
+	reqBody := suggestionRequest(prompt, candidates)
+
+	var keyed interface{} = reqBody
+	if c.Tools != nil {
+		keyed = []interface{}{reqBody, c.Tools.declarations(), c.Tools.MaxRounds}
+	}
+	key, err := cacheKey(c.Model, keyed)
+	if err != nil {
+		return nil, err
+	}
+	var cached []data.AISuggestion
+	if c.Cache.Get(key, &cached) {
+		return cached, nil
+	}
+
+	if c.Tools != nil {
+		prompt, candidates, err = c.useTools(ctx, prompt, candidates)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = suggestionRequest(prompt, candidates)
+	}
+
+	gResp, err := c.generate(ctx, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := firstText(gResp)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := parseSuggestions(text, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Cache.Put(key, parsed)
+	return parsed, nil
+}
+
+// suggestionRequest asks for structured picks from candidates.
+func suggestionRequest(prompt string, candidates []data.Problem) map[string]interface{} {
+	return map[string]interface{}{
+		"contents": []map[string]interface{}{
+			{
+				"role": "user",
+				"parts": []map[string]string{
+					{"text": prompt},
+				},
+			},
+		},
+		"generationConfig": jsonOutputConfig(suggestionSchema(candidates)),
 	}
+}
 
+// GenerateText sends a single prompt, with an optional system instruction,
+// and returns the model's plain text answer.
+func (c *Client) GenerateText(ctx context.Context, system, prompt string) (string, error) {
 	reqBody := map[string]interface{}{
 		"contents": []map[string]interface{}{
 			{
@@ -31,64 +149,133 @@ func GetSuggestions(prompt string) ([]data.AISuggestion, error) {
 			},
 		},
 	}
+	if system != "" {
+		reqBody["systemInstruction"] = map[string]interface{}{
+			"parts": []map[string]string{{"text": system}},
+		}
+	}
 
-	jsonData, _ := json.Marshal(reqBody)
+	gResp, err := c.generate(ctx, reqBody)
+	if err != nil {
+		return "", err
+	}
+	return firstText(gResp)
+}
 
-	// REST endpoint for Gemini 2.0 Flash-Lite
-	url := fmt.Sprintf(
-		"https://generativelanguage.googleapis.com/v1beta/%s:generateContent?key=%s",
-		model, apiKey,
-	)
+// firstText returns the text of the first candidate.
+func firstText(gResp *GeminiResponse) (string, error) {
+	if len(gResp.Candidates) == 0 ||
+		len(gResp.Candidates[0].Content.Parts) == 0 {
+		return "", fmt.Errorf("%w: empty AI response", ErrInvalidOutput)
+	}
+	return gResp.Candidates[0].Content.Parts[0].Text, nil
+}
 
-	resp, err := http.Post(url, "application/json", bytes.NewReader(jsonData))
-	if err != nil {
-		return nil, err
+// generate posts reqBody to generateContent, retrying rate limited and
+// overloaded responses with backoff until the deadline or ctx expires.
+func (c *Client) generate(ctx context.Context, reqBody interface{}) (*GeminiResponse, error) {
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	jsonData, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("gemini API error (%d): %s", resp.StatusCode, string(body))
+	for attempt := 1; ; attempt++ {
+		gResp, err := c.post(ctx, jsonData)
+		if err == nil {
+			c.reportUsage(gResp)
+			return gResp, nil
+		}
+
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if !IsRetryable(err) || attempt > c.MaxRetries {
+			return nil, err
+		}
+
+		var retryAfter time.Duration
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			retryAfter = apiErr.RetryAfter
+		}
+
+		wait := backoff(attempt, retryAfter)
+		if c.OnRetry != nil {
+			c.OnRetry(attempt, wait, err)
+		}
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
 	}
+}
 
-	var gResp GeminiResponse
-	if err := json.Unmarshal(body, &gResp); err != nil {
-		return nil, fmt.Errorf("invalid Gemini response JSON: %w\nRaw: %s",
-			err, string(body))
+// reportUsage passes the response's token counts to OnUsage.
+func (c *Client) reportUsage(gResp *GeminiResponse) {
+	if c.OnUsage == nil || gResp.UsageMetadata == nil {
+		return
 	}
+	m := gResp.UsageMetadata
+	c.OnUsage(Usage{
+		Model:          c.Model,
+		PromptTokens:   m.PromptTokenCount,
+		ResponseTokens: m.CandidatesTokenCount,
+		CostUSD:        EstimateCost(c.Model, m.PromptTokenCount, m.CandidatesTokenCount),
+	})
+}
 
-	if len(gResp.Candidates) == 0 ||
-		len(gResp.Candidates[0].Content.Parts) == 0 {
-		return nil, errors.New("empty AI response")
+// checkBudget fails once this month's recorded spend reaches the configured budget.
+func checkBudget(store *data.Store, cfg data.Config) error {
+	if cfg.MonthlyBudgetUSD <= 0 {
+		return nil
+	}
+	usage, err := store.LoadUsage()
+	if err != nil {
+		return err
 	}
+	if spent := data.MonthCost(usage, time.Now()); spent >= cfg.MonthlyBudgetUSD {
+		return fmt.Errorf("%w ($%.4f of $%.2f)", ErrBudgetExceeded, spent, cfg.MonthlyBudgetUSD)
+	}
+	return nil
+}
 
-	text := gResp.Candidates[0].Content.Parts[0].Text
+// post sends a single generateContent request.
+func (c *Client) post(ctx context.Context, jsonData []byte) (*GeminiResponse, error) {
+	url := fmt.Sprintf("%s/%s:generateContent", c.BaseURL, c.Model)
 
-	// extract clean JSON array
-	clean := ExtractJSON(text)
-	if clean == "" {
-		return nil, fmt.Errorf("AI output does not contain JSON array.\nRaw: %s", text)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(jsonData))
+	if err != nil {
+		return nil, err
 	}
+	req.Header.Set("Content-Type", "application/json")
+	// header rather than ?key= so the key never shows up in errors or fixtures
+	req.Header.Set("x-goog-api-key", c.APIKey)
 
-	var parsed []data.AISuggestion
-	if err := json.Unmarshal([]byte(clean), &parsed); err != nil {
-		return nil, fmt.Errorf("AI output JSON parse error: %v\nCleaned: %s", err, clean)
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
 	}
+	defer resp.Body.Close()
 
-	return parsed, nil
-}
-
-func loadAPIKey() string {
-	b, err := os.ReadFile("data/config.json")
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return ""
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, body)
+	}
+
+	var gResp GeminiResponse
+	if err := json.Unmarshal(body, &gResp); err != nil {
+		return nil, fmt.Errorf("invalid Gemini response JSON: %w\nRaw: %s",
+			err, string(body))
 	}
 
-	var cfg map[string]string
-	json.Unmarshal(b, &cfg)
-	return cfg["api_key"]
+	return &gResp, nil
 }
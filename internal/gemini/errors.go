@@ -0,0 +1,123 @@
+package gemini
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrMissingAPIKey means config.json has no key; retrying cannot help.
+var ErrMissingAPIKey = errors.New("API key missing. Run `goleet init` again")
+
+// ErrBudgetExceeded means this month's estimated AI spend reached
+// monthly_budget_usd; callers should fall back to offline behavior.
+var ErrBudgetExceeded = errors.New("monthly AI budget reached")
+
+// ErrInvalidOutput wraps responses that arrived fine but could not be used;
+// asking again (possibly with a stricter prompt) may help.
+var ErrInvalidOutput = errors.New("invalid AI output")
+
+// APIError is a non-200 response from the Gemini API.
+type APIError struct {
+	StatusCode int
+	Status     string // e.g. RESOURCE_EXHAUSTED, INVALID_ARGUMENT
+	Message    string
+	RetryAfter time.Duration // server-provided wait, 0 if none
+}
+
+func (e *APIError) Error() string {
+	if e.invalidKey() {
+		return "gemini API key was rejected; run `goleet init` again"
+	}
+	return fmt.Sprintf("gemini API error (%d %s): %s", e.StatusCode, e.Status, e.Message)
+}
+
+// Retryable reports whether the request may succeed if sent again later
+// (rate limiting or a temporarily overloaded backend).
+func (e *APIError) Retryable() bool {
+	switch e.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func (e *APIError) invalidKey() bool {
+	return e.StatusCode == http.StatusUnauthorized ||
+		e.StatusCode == http.StatusForbidden ||
+		strings.Contains(e.Message, "API key not valid") ||
+		strings.Contains(e.Message, "API_KEY_INVALID")
+}
+
+// IsRetryable reports whether err is worth retrying.
+func IsRetryable(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Retryable()
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// IsFatal reports whether err will happen again no matter how often the
+// call is repeated (bad or missing key, malformed request, ...).
+func IsFatal(err error) bool {
+	if errors.Is(err, ErrMissingAPIKey) || errors.Is(err, ErrBudgetExceeded) {
+		return true
+	}
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && !apiErr.Retryable()
+}
+
+// newAPIError builds an APIError from an HTTP response and its body.
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    strings.TrimSpace(string(body)),
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+
+	var payload struct {
+		Error struct {
+			Message string `json:"message"`
+			Status  string `json:"status"`
+			Details []struct {
+				RetryDelay string `json:"retryDelay"`
+			} `json:"details"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &payload) == nil && payload.Error.Message != "" {
+		apiErr.Message = payload.Error.Message
+		apiErr.Status = payload.Error.Status
+		for _, d := range payload.Error.Details {
+			if wait, err := time.ParseDuration(d.RetryDelay); err == nil && apiErr.RetryAfter == 0 {
+				apiErr.RetryAfter = wait
+			}
+		}
+	}
+
+	return apiErr
+}
+
+// parseRetryAfter understands both forms of the header: seconds or an HTTP date.
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
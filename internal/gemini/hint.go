@@ -0,0 +1,57 @@
+package gemini
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+const hintSystem = `You are a patient coding interview coach. You give hints for LeetCode
+problems without spoiling them. Never write code and never state the full
+solution. Answer in at most 4 short sentences of plain text.`
+
+// hintInstructions says how far each level may go.
+var hintInstructions = map[int]string{
+	data.HintNudge:    "Give a gentle nudge: a question or observation that points in the right direction. Do not name the technique or data structure.",
+	data.HintApproach: "Name the general approach or data structure that works well, and why, without describing the steps.",
+	data.HintSketch:   "Sketch the algorithm as a few high-level steps with its time complexity. Still no code.",
+}
+
+// GetHint asks for a hint of the given level. previous holds the hints
+// already revealed for lower levels, so the new one builds on them.
+func (c *Client) GetHint(ctx context.Context, p data.Problem, level int, previous map[int]data.HintRecord) (string, error) {
+	return c.GenerateText(ctx, hintSystem, BuildHintPrompt(p, level, previous))
+}
+
+// BuildHintPrompt renders the user prompt of a hint request.
+func BuildHintPrompt(p data.Problem, level int, previous map[int]data.HintRecord) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "PROBLEM: %s. %s (%s)\n", p.ID, p.Title, p.Difficulty)
+	fmt.Fprintf(&b, "LINK: %s\n", p.Link())
+	if topics := p.Topics(); len(topics) > 0 {
+		fmt.Fprintf(&b, "TOPICS (do not reveal unless the level allows): %s\n", strings.Join(topics, ", "))
+	}
+
+	levels := make([]int, 0, len(previous))
+	for l := range previous {
+		if l < level {
+			levels = append(levels, l)
+		}
+	}
+	sort.Ints(levels)
+	if len(levels) > 0 {
+		b.WriteString("\nHINTS ALREADY GIVEN (do not repeat them):\n")
+		for _, l := range levels {
+			fmt.Fprintf(&b, "- Level %d: %s\n", l, previous[l].Text)
+		}
+	}
+
+	fmt.Fprintf(&b, "\nHINT LEVEL %d of %d (%s): %s\n",
+		level, data.MaxHintLevel, data.HintLevelName(level), hintInstructions[level])
+
+	return b.String()
+}
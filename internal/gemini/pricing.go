@@ -0,0 +1,27 @@
+package gemini
+
+import "strings"
+
+// price is USD per million tokens.
+type price struct {
+	Input  float64
+	Output float64
+}
+
+// prices are the published pay-as-you-go rates; estimates only.
+var prices = map[string]price{
+	"gemini-2.0-flash-lite": {Input: 0.075, Output: 0.30},
+	"gemini-2.0-flash":      {Input: 0.10, Output: 0.40},
+	"gemini-1.5-flash":      {Input: 0.075, Output: 0.30},
+	"gemini-1.5-pro":        {Input: 1.25, Output: 5.00},
+}
+
+// EstimateCost returns the approximate USD cost of one call. Unknown
+// models are priced like the default model.
+func EstimateCost(name string, promptTokens, responseTokens int) float64 {
+	p, ok := prices[strings.TrimPrefix(name, "models/")]
+	if !ok {
+		p = prices[strings.TrimPrefix(model, "models/")]
+	}
+	return (float64(promptTokens)*p.Input + float64(responseTokens)*p.Output) / 1e6
+}
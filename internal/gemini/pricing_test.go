@@ -0,0 +1,13 @@
+package gemini
+
+import "testing"
+
+func TestEstimateCostUnknownModelUsesDefault(t *testing.T) {
+	want := EstimateCost(model, 1000, 500)
+	if want == 0 {
+		t.Fatalf("default model %s has no price", model)
+	}
+	if got := EstimateCost("models/gemini-9-experimental", 1000, 500); got != want {
+		t.Errorf("unknown model cost %v, want default model cost %v", got, want)
+	}
+}
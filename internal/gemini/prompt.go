@@ -1,133 +1,140 @@
 package gemini
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 	"strings"
-	"time"
 
 	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/recommend"
 )
 
-//
-// =============== PUBLIC API ===============
-//
-
-func BuildPrompt(
-	solved []data.SolvedProblem,
-	history []data.HistoryEntry,
-	allProblems []data.Problem,
-	seriousness int,
-) string {
-
-	// 1️⃣ Compute dynamic streak
-	currentStreak := computeStreak(solved)
-
-	// 2️⃣ Compute weak topics
-	weakTopics := computeWeakTopics(allProblems, solved)
-
-	// 3️⃣ Difficulty guidance driven by streak level
-	difficultyAdvice := difficultyBasedOnStreak(currentStreak)
+// DefaultTokenBudget bounds the estimated size of a suggestion prompt.
+const DefaultTokenBudget = 2000
 
-	// 4️⃣ Format solved lines
-	solvedLines := []string{}
-	for _, s := range solved {
-		solvedLines = append(solvedLines, fmt.Sprintf("%s | %s", s.ID, s.Title))
-	}
+const (
+	defaultRecentSolved = 10 // most recent solves listed individually
+	minCandidates       = 5  // the budget never shrinks the pool below this
+	maxSummaryTopics    = 15 // per-topic aggregates listed in the summary
+)
 
-	// 5️⃣ Format recent history IDs
-	historyIDs := []string{}
-	for _, h := range history {
-		historyIDs = append(historyIDs, h.ID)
-	}
+// PromptInput is everything a suggestion prompt is built from.
+type PromptInput struct {
+	Solved       []data.SolvedProblem
+	History      []data.HistoryEntry
+	Catalog      *data.Catalog
+	Candidates   []data.Problem
+	Filter       data.Filter
+	Seriousness  int
+	TokenBudget  int    // 0 = DefaultTokenBudget
+	TemplatesDir string // per-user overrides, e.g. data/templates; "" = embedded only
+	Tools        bool   // the model can call CatalogTools, so history is left out
+}
 
-	// 6️⃣ Build final prompt
-	return fmt.Sprintf(`You are GoLeet AI. Suggest exactly 3 new LeetCode problems in JSON ONLY.
-
-USER_SOLVED (never repeat):
-%s
-
-RECENT_SUGGESTIONS (avoid repeating):
-%s
-
-USER_STATS:
-Current_Streak: %d days
-Weak_Topics: %s
-
-STREAK_BASED_DIFFICULTY_GUIDANCE:
-%s
-
-GOAL:
-- Suggest 3 UNSOLVED, NEW problems.
-- Follow difficulty guidance above.
-- Prefer weak topics moderately.
-- Ensure variety (avoid repeating topics too much).
-- Avoid all solved and history items.
-- NO explanations. NO text outside JSON.
-
-STRICT JSON OUTPUT FORMAT:
-[
-  {"title": "", "number": 0, "topics": ["",""]},
-  {"title": "", "number": 0, "topics": ["",""]},
-  {"title": "", "number": 0, "topics": ["",""]}
-]
-
-SERIOUSNESS_MODE: %d
-(1 = normal, 2 = stronger diversity, 3 = strict filtering)
-
-Return ONLY the JSON array.
-`,
-		strings.Join(solvedLines, "\n"),
-		strings.Join(historyIDs, ", "),
-		currentStreak,
-		strings.Join(weakTopics, ", "),
-		difficultyAdvice,
-		seriousness,
-	)
+// Prompt is a rendered prompt plus the candidates it actually lists
+// (the budget may have trimmed the pool).
+type Prompt struct {
+	Text       string
+	Candidates []data.Problem
+	Tokens     int // estimated
 }
 
 //
-// =============== HELPERS ===============
+// =============== PUBLIC API ===============
 //
 
-// Computes the consecutive-day streak based on solved.json
-func computeStreak(solved []data.SolvedProblem) int {
-	if len(solved) == 0 {
-		return 0
+// BuildPrompt renders the suggestion prompt template, shrinking the list of
+// recent solves and then the candidate pool until it fits the token budget.
+func BuildPrompt(in PromptInput) (Prompt, error) {
+	budget := in.TokenBudget
+	if budget <= 0 {
+		budget = DefaultTokenBudget
 	}
 
-	// Sort by date descending
-	sort.Slice(solved, func(i, j int) bool {
-		return solved[i].Date > solved[j].Date
-	})
-
-	format := "2006-01-02"
-	streak := 1
-
-	lastDate, err := time.Parse(format, solved[0].Date)
+	tmpl, err := LoadPromptTemplate(in.TemplatesDir)
 	if err != nil {
-		return 1
+		return Prompt{}, err
 	}
 
-	for i := 1; i < len(solved); i++ {
-		curDate, err := time.Parse(format, solved[i].Date)
-		if err != nil {
+	recent := defaultRecentSolved
+	candidates := in.Candidates
+
+	for {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, promptData(in, candidates, recent)); err != nil {
+			return Prompt{}, fmt.Errorf("prompt template: %w", err)
+		}
+		text := buf.String()
+		tokens := EstimateTokens(text)
+
+		switch {
+		case tokens <= budget:
+		case recent > 0:
+			recent /= 2
+			continue
+		case len(candidates) > minCandidates:
+			candidates = candidates[:max(minCandidates, len(candidates)*3/4)]
 			continue
 		}
 
-		diff := lastDate.Sub(curDate).Hours()
+		return Prompt{Text: text, Candidates: candidates, Tokens: tokens}, nil
+	}
+}
 
-		if diff <= 24 && diff >= 0 {
-			streak++
-			lastDate = curDate
-		} else {
-			break
-		}
+// EstimateTokens approximates the token count of s (~4 characters per token).
+func EstimateTokens(s string) int {
+	return (len(s) + 3) / 4
+}
+
+// PromptData is what a suggestion prompt template can use.
+type PromptData struct {
+	Count            int                  // how many problems to pick
+	Candidates       []data.Problem       // the only allowed answers
+	Solved           []data.SolvedProblem // every solve
+	RecentSolved     []data.SolvedProblem // newest first, trimmed to fit the budget
+	SolvedSummary    string               // aggregates plus RecentSolved, pre-rendered
+	History          []data.HistoryEntry
+	HistoryIDs       []string
+	Feedback         string // learned from skips, "" if none
+	Streak           int
+	WeakTopics       []string
+	DifficultyAdvice string
+	Filters          string // "" if none
+	Seriousness      int
+	Tools            bool // tool calling is on; look data up instead of listing it
+}
+
+func promptData(in PromptInput, candidates []data.Problem, recent int) PromptData {
+	currentStreak := recommend.Streak(in.Solved)
+
+	historyIDs := []string{}
+	for _, h := range in.History {
+		historyIDs = append(historyIDs, h.ID)
 	}
 
-	return streak
+	return PromptData{
+		Count:            suggestionCount,
+		Candidates:       candidates,
+		Solved:           in.Solved,
+		RecentSolved:     recentSolved(in.Solved, recent),
+		SolvedSummary:    summarizeSolved(in.Catalog, in.Solved, recent),
+		History:          in.History,
+		HistoryIDs:       historyIDs,
+		Feedback:         recommend.LearnFeedback(in.Catalog, in.History).Describe(),
+		Streak:           currentStreak,
+		WeakTopics:       recommend.WeakTopics(in.Catalog, in.Solved),
+		DifficultyAdvice: difficultyBasedOnStreak(currentStreak),
+		Filters:          in.Filter.Describe(),
+		Seriousness:      in.Seriousness,
+		Tools:            in.Tools,
+	}
 }
 
+//
+// =============== HELPERS ===============
+//
+
 // Difficulty personality based on streak
 func difficultyBasedOnStreak(streak int) string {
 	switch {
@@ -140,57 +147,68 @@ func difficultyBasedOnStreak(streak int) string {
 	}
 }
 
-// Computes weak topics (least solved vs most available)
-func computeWeakTopics(allProblems []data.Problem, solved []data.SolvedProblem) []string {
-
-	// Count total availability per topic
-	totalCount := map[string]int{}
-	for _, p := range allProblems {
-		for _, t := range p.TopicTags {
-			totalCount[t.Name]++
-		}
+// summarizeSolved aggregates solves per difficulty and topic and lists only
+// the most recent ones, so the prompt stays small however much was solved.
+func summarizeSolved(catalog *data.Catalog, solved []data.SolvedProblem, recent int) string {
+	if len(solved) == 0 {
+		return "Nothing solved yet."
 	}
 
-	// Count solved per topic
-	solvedCount := map[string]int{}
+	byDifficulty := map[string]int{}
+	byTopic := map[string]int{}
 	for _, s := range solved {
-		for _, p := range allProblems {
-			if p.ID == s.ID {
-				for _, t := range p.TopicTags {
-					solvedCount[t.Name]++
-				}
-			}
+		p, ok := catalog.ByID(s.ID)
+		if !ok {
+			continue
+		}
+		byDifficulty[p.Difficulty]++
+		for _, t := range p.TopicTags {
+			byTopic[t.Name]++
 		}
 	}
 
-	// Calculate weakness score = solved / total
-	type pair struct {
-		Topic string
-		Score float64
+	lines := []string{
+		fmt.Sprintf("Total: %d (Easy %d, Medium %d, Hard %d)",
+			len(solved), byDifficulty["Easy"], byDifficulty["Medium"], byDifficulty["Hard"]),
 	}
 
-	scoreList := []pair{}
-	for topic, total := range totalCount {
-		solved := solvedCount[topic]
-		score := float64(solved) / float64(total) // low = weak
-		scoreList = append(scoreList, pair{topic, score})
+	topics := make([]string, 0, len(byTopic))
+	for t := range byTopic {
+		topics = append(topics, t)
 	}
-
-	// Sort by increasing score (weakest first)
-	sort.Slice(scoreList, func(i, j int) bool {
-		return scoreList[i].Score < scoreList[j].Score
+	sort.Slice(topics, func(i, j int) bool {
+		if byTopic[topics[i]] != byTopic[topics[j]] {
+			return byTopic[topics[i]] > byTopic[topics[j]]
+		}
+		return topics[i] < topics[j]
 	})
-
-	// Pick top 3 weakest topics
-	limit := 3
-	if len(scoreList) < limit {
-		limit = len(scoreList)
+	if len(topics) > maxSummaryTopics {
+		topics = topics[:maxSummaryTopics]
 	}
+	counts := make([]string, 0, len(topics))
+	for _, t := range topics {
+		counts = append(counts, fmt.Sprintf("%s %d", t, byTopic[t]))
+	}
+	lines = append(lines, "By topic: "+strings.Join(counts, ", "))
 
-	weakTopics := []string{}
-	for i := 0; i < limit; i++ {
-		weakTopics = append(weakTopics, scoreList[i].Topic)
+	if recent > 0 {
+		lines = append(lines, "Most recent:")
+		for _, s := range recentSolved(solved, recent) {
+			lines = append(lines, fmt.Sprintf("%s | %s | %s", s.ID, s.Title, s.Date))
+		}
 	}
 
-	return weakTopics
+	return strings.Join(lines, "\n")
+}
+
+// recentSolved returns the n newest solves, newest first.
+func recentSolved(solved []data.SolvedProblem, n int) []data.SolvedProblem {
+	sorted := append([]data.SolvedProblem(nil), solved...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date > sorted[j].Date
+	})
+	if len(sorted) > n {
+		sorted = sorted[:n]
+	}
+	return sorted
 }
@@ -0,0 +1,146 @@
+package gemini
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// Environment variables that switch the HTTP client to fixture mode.
+const (
+	EnvRecord = "GOLEET_AI_RECORD" // directory to write fixtures to
+	EnvReplay = "GOLEET_AI_REPLAY" // directory to answer requests from
+)
+
+// fixture is one recorded HTTP exchange. Request headers, which carry
+// the API key, are never part of it.
+type fixture struct {
+	Method   string            `json:"method"`
+	Path     string            `json:"path"`
+	Request  json.RawMessage   `json:"request"`
+	Status   int               `json:"status"`
+	Header   map[string]string `json:"header,omitempty"`
+	Response json.RawMessage   `json:"response"`
+}
+
+// ReplayTransport records real exchanges to Dir, or, with Replay set,
+// serves them from Dir without touching the network, so AI flows can be
+// run deterministically.
+type ReplayTransport struct {
+	Dir    string
+	Replay bool
+	Next   http.RoundTripper // used when recording; nil = http.DefaultTransport
+}
+
+// NewTransportFromEnv returns a recording or replaying transport when the
+// corresponding environment variable is set, nil otherwise.
+func NewTransportFromEnv() *ReplayTransport {
+	if dir := os.Getenv(EnvReplay); dir != "" {
+		return &ReplayTransport{Dir: dir, Replay: true}
+	}
+	if dir := os.Getenv(EnvRecord); dir != "" {
+		return &ReplayTransport{Dir: dir}
+	}
+	return nil
+}
+
+func (t *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	name := fixtureName(req.Method, req.URL.Path, body)
+	path := filepath.Join(t.Dir, name)
+
+	if t.Replay {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("no recorded fixture for this request (%s): %w", name, err)
+		}
+		var f fixture
+		if err := json.Unmarshal(raw, &f); err != nil {
+			return nil, fmt.Errorf("fixture %s: %w", name, err)
+		}
+		return f.response(req), nil
+	}
+
+	next := t.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	f := fixture{
+		Method:   req.Method,
+		Path:     req.URL.Path,
+		Request:  jsonOrString(body),
+		Status:   resp.StatusCode,
+		Response: jsonOrString(respBody),
+	}
+	if ra := resp.Header.Get("Retry-After"); ra != "" {
+		f.Header = map[string]string{"Retry-After": ra}
+	}
+	if out, err := json.MarshalIndent(f, "", "  "); err == nil {
+		if os.MkdirAll(t.Dir, 0755) == nil {
+			_ = os.WriteFile(path, out, 0644)
+		}
+	}
+	return resp, nil
+}
+
+func (f fixture) response(req *http.Request) *http.Response {
+	body := []byte(f.Response)
+	var s string
+	if json.Unmarshal(f.Response, &s) == nil {
+		body = []byte(s) // response was stored as a plain string
+	}
+
+	header := http.Header{"Content-Type": {"application/json"}}
+	for k, v := range f.Header {
+		header.Set(k, v)
+	}
+	return &http.Response{
+		StatusCode:    f.Status,
+		Status:        fmt.Sprintf("%d %s", f.Status, http.StatusText(f.Status)),
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// fixtureName addresses a fixture by method, path and body.
+func fixtureName(method, path string, body []byte) string {
+	sum := sha256.Sum256([]byte(method + " " + path + "\n" + string(body)))
+	return hex.EncodeToString(sum[:8]) + ".json"
+}
+
+func jsonOrString(b []byte) json.RawMessage {
+	if json.Valid(b) {
+		return json.RawMessage(b)
+	}
+	s, _ := json.Marshal(string(b))
+	return s
+}
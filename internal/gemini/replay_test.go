@@ -0,0 +1,149 @@
+package gemini
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+var update = flag.Bool("update", false, "re-record testdata/replay from a local fake Gemini server")
+
+const replayDir = "testdata/replay"
+
+const replayPrompt = "Suggest 2 problems from the candidates."
+
+var replayCandidates = []data.Problem{
+	{ID: "1", Title: "Two Sum", Difficulty: "Easy", TitleSlug: "two-sum", TopicTags: []data.TopicTag{{Name: "Array"}, {Name: "Hash Table"}}},
+	{ID: "15", Title: "3Sum", Difficulty: "Medium", TitleSlug: "3sum", TopicTags: []data.TopicTag{{Name: "Array"}, {Name: "Two Pointers"}}},
+	{ID: "20", Title: "Valid Parentheses", Difficulty: "Easy", TitleSlug: "valid-parentheses", TopicTags: []data.TopicTag{{Name: "Stack"}}},
+}
+
+// recordFixtures answers the suggestion request from a fake server and
+// records the exchange like GOLEET_AI_RECORD does against the real API.
+func recordFixtures(t *testing.T) {
+	t.Helper()
+	answer := `[{"title":"Two Sum","number":1,"topics":["Array","Hash Table"],"reason":"Warm up with hashing."},` +
+		`{"title":"3Sum","number":15,"topics":["Array","Two Pointers"],"reason":"Builds on Two Sum with two pointers."}]`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := map[string]interface{}{
+			"candidates":    []interface{}{map[string]interface{}{"content": Content{Role: "model", Parts: []Part{{Text: answer}}}}},
+			"usageMetadata": UsageMetadata{PromptTokenCount: 412, CandidatesTokenCount: 58, TotalTokenCount: 470},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	if err := os.RemoveAll(replayDir); err != nil {
+		t.Fatal(err)
+	}
+	client := &Client{
+		APIKey:  "test",
+		Model:   model,
+		BaseURL: srv.URL + "/v1beta", // same path as the real API, so fixture names match
+		HTTP:    &http.Client{Transport: &ReplayTransport{Dir: replayDir}},
+	}
+	if _, err := client.GetSuggestions(context.Background(), replayPrompt, replayCandidates); err != nil {
+		t.Fatalf("recording: %v", err)
+	}
+}
+
+func TestGetSuggestionsReplay(t *testing.T) {
+	if *update {
+		recordFixtures(t)
+	}
+	t.Setenv(EnvReplay, replayDir)
+
+	client, err := NewClient(data.NewStoreAt(t.TempDir()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var usage []Usage
+	client.OnUsage = func(u Usage) { usage = append(usage, u) }
+
+	got, err := client.GetSuggestions(context.Background(), replayPrompt, replayCandidates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].Number != 1 || got[1].Number != 15 {
+		t.Fatalf("unexpected suggestions %+v", got)
+	}
+	if got[1].Reason != "Builds on Two Sum with two pointers." {
+		t.Errorf("reason %q", got[1].Reason)
+	}
+	if len(usage) != 1 || usage[0].PromptTokens != 412 || usage[0].ResponseTokens != 58 || usage[0].CostUSD == 0 {
+		t.Errorf("usage %+v", usage)
+	}
+
+	// the same request again is answered from the cache, not the transport
+	client.HTTP = &http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("network used")
+	})}
+	again, err := client.GetSuggestions(context.Background(), replayPrompt, replayCandidates)
+	if err != nil {
+		t.Fatalf("cached call: %v", err)
+	}
+	if len(again) != len(got) || again[0].Number != got[0].Number || again[0].Reason != got[0].Reason {
+		t.Errorf("cached %+v, want %+v", again, got)
+	}
+}
+
+func TestReplayMissingFixture(t *testing.T) {
+	t.Setenv(EnvReplay, replayDir)
+
+	client, err := NewClient(data.NewStoreAt(t.TempDir()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.MaxRetries = 0
+	if _, err := client.GetSuggestions(context.Background(), "an unrecorded prompt", replayCandidates); err == nil {
+		t.Fatal("expected an error for a request without a fixture")
+	}
+}
+
+func TestCacheTTLExpiry(t *testing.T) {
+	c := &Cache{Dir: t.TempDir(), TTL: time.Hour}
+	c.Put("k", []string{"a"})
+
+	var v []string
+	if !c.Get("k", &v) || len(v) != 1 || v[0] != "a" {
+		t.Fatalf("fresh entry not returned: %v", v)
+	}
+
+	// age the entry past the TTL
+	path := filepath.Join(c.Dir, "k.json")
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e cacheEntry
+	if err := json.Unmarshal(raw, &e); err != nil {
+		t.Fatal(err)
+	}
+	e.Created = time.Now().Add(-2 * time.Hour)
+	raw, _ = json.Marshal(e)
+	if err := os.WriteFile(path, raw, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Get("k", &v) {
+		t.Fatal("expired entry was returned")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expired entry not removed: %v", err)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
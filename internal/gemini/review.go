@@ -0,0 +1,123 @@
+package gemini
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+// CodeReview is the model's assessment of a solution.
+type CodeReview struct {
+	Verdict         string   `json:"verdict"` // one-line summary
+	Correctness     []string `json:"correctness"`
+	TimeComplexity  string   `json:"timeComplexity"`
+	SpaceComplexity string   `json:"spaceComplexity"`
+	Improvements    []string `json:"improvements"`
+}
+
+const reviewSystem = `You are a senior engineer reviewing a LeetCode solution. Be specific and
+concise. Point out bugs and missed edge cases, state the time and space
+complexity of the code as written, and suggest idiomatic improvements for
+its language. Do not rewrite the whole solution.`
+
+var reviewSchema = map[string]interface{}{
+	"type": "OBJECT",
+	"properties": map[string]interface{}{
+		"verdict":         map[string]interface{}{"type": "STRING"},
+		"correctness":     map[string]interface{}{"type": "ARRAY", "items": map[string]interface{}{"type": "STRING"}},
+		"timeComplexity":  map[string]interface{}{"type": "STRING"},
+		"spaceComplexity": map[string]interface{}{"type": "STRING"},
+		"improvements":    map[string]interface{}{"type": "ARRAY", "items": map[string]interface{}{"type": "STRING"}},
+	},
+	"required":         []string{"verdict", "correctness", "timeComplexity", "spaceComplexity", "improvements"},
+	"propertyOrdering": []string{"verdict", "correctness", "timeComplexity", "spaceComplexity", "improvements"},
+}
+
+// ReviewCode sends the problem and the user's code for review.
+func (c *Client) ReviewCode(ctx context.Context, p data.Problem, lang, code string) (*CodeReview, error) {
+	reqBody := map[string]interface{}{
+		"systemInstruction": map[string]interface{}{
+			"parts": []map[string]string{{"text": reviewSystem}},
+		},
+		"contents": []map[string]interface{}{
+			{
+				"role": "user",
+				"parts": []map[string]string{
+					{"text": BuildReviewPrompt(p, lang, code)},
+				},
+			},
+		},
+		"generationConfig": jsonOutputConfig(reviewSchema),
+	}
+
+	key, err := cacheKey(c.Model, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	var cached CodeReview
+	if c.Cache.Get(key, &cached) {
+		return &cached, nil
+	}
+
+	gResp, err := c.generate(ctx, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	text, err := firstText(gResp)
+	if err != nil {
+		return nil, err
+	}
+
+	var review CodeReview
+	dec := json.NewDecoder(bytes.NewReader([]byte(text)))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&review); err != nil {
+		return nil, &SchemaError{Reason: err.Error(), Raw: text}
+	}
+	if strings.TrimSpace(review.Verdict) == "" || review.TimeComplexity == "" || review.SpaceComplexity == "" {
+		return nil, &SchemaError{Reason: "missing verdict or complexity", Raw: text}
+	}
+
+	c.Cache.Put(key, review)
+	return &review, nil
+}
+
+// BuildReviewPrompt renders the user prompt of a review request.
+func BuildReviewPrompt(p data.Problem, lang, code string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "PROBLEM: %s. %s (%s)\n", p.ID, p.Title, p.Difficulty)
+	fmt.Fprintf(&b, "LINK: %s\n", p.Link())
+	if topics := p.Topics(); len(topics) > 0 {
+		fmt.Fprintf(&b, "TOPICS: %s\n", strings.Join(topics, ", "))
+	}
+	fmt.Fprintf(&b, "LANGUAGE: %s\n\nCODE:\n```%s\n%s\n```\n", lang, lang, strings.TrimRight(code, "\n"))
+	return b.String()
+}
+
+// Markdown renders the review for saving next to the problem's notes.
+func (r *CodeReview) Markdown(p data.Problem, file string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Review: %s. %s\n\n", p.ID, p.Title)
+	fmt.Fprintf(&b, "- File: %s\n- Link: %s\n\n", file, p.Link())
+	fmt.Fprintf(&b, "**Verdict:** %s\n\n", r.Verdict)
+	b.WriteString("## Correctness\n\n")
+	writeList(&b, r.Correctness, "No concerns.")
+	fmt.Fprintf(&b, "\n## Complexity\n\n- Time: %s\n- Space: %s\n", r.TimeComplexity, r.SpaceComplexity)
+	b.WriteString("\n## Improvements\n\n")
+	writeList(&b, r.Improvements, "None.")
+	return b.String()
+}
+
+func writeList(b *strings.Builder, items []string, empty string) {
+	if len(items) == 0 {
+		b.WriteString(empty + "\n")
+		return
+	}
+	for _, it := range items {
+		fmt.Fprintf(b, "- %s\n", it)
+	}
+}
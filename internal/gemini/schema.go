@@ -0,0 +1,148 @@
+package gemini
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+// suggestionCount is how many suggestions the model is asked for.
+const suggestionCount = 3
+
+// suggestionSchema is the responseSchema (OpenAPI subset) for []AISuggestion.
+// Titles are restricted to the candidate pool so the model cannot invent problems.
+func suggestionSchema(candidates []data.Problem) map[string]interface{} {
+	titles := make([]string, 0, len(candidates))
+	for _, p := range candidates {
+		titles = append(titles, p.Title)
+	}
+
+	return map[string]interface{}{
+		"type":     "ARRAY",
+		"minItems": 1,
+		"maxItems": suggestionCount,
+		"items": map[string]interface{}{
+			"type": "OBJECT",
+			"properties": map[string]interface{}{
+				"title": map[string]interface{}{
+					"type":   "STRING",
+					"format": "enum",
+					"enum":   titles,
+				},
+				"number": map[string]interface{}{"type": "INTEGER"},
+				"topics": map[string]interface{}{
+					"type":  "ARRAY",
+					"items": map[string]interface{}{"type": "STRING"},
+				},
+				"reason": map[string]interface{}{"type": "STRING"},
+			},
+			"required":         []string{"title", "number", "topics", "reason"},
+			"propertyOrdering": []string{"title", "number", "topics", "reason"},
+		},
+	}
+}
+
+// jsonOutputConfig asks Gemini for JSON that conforms to schema.
+func jsonOutputConfig(schema interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"responseMimeType": "application/json",
+		"responseSchema":   schema,
+	}
+}
+
+// SchemaError means the model answered, but not with what the schema demands.
+// It wraps ErrInvalidOutput so callers may retry.
+type SchemaError struct {
+	Reason string
+	Raw    string
+}
+
+func (e *SchemaError) Error() string {
+	return fmt.Sprintf("AI response violates schema: %s\nRaw: %s", e.Reason, e.Raw)
+}
+
+func (e *SchemaError) Unwrap() error {
+	return ErrInvalidOutput
+}
+
+// parseSuggestions strictly decodes and validates a structured response.
+// Every suggestion must name a problem of the candidate pool; a wrong
+// number next to a pool title is corrected from the title, and repeated
+// picks are dropped.
+func parseSuggestions(text string, candidates []data.Problem) ([]data.AISuggestion, error) {
+	byTitle := map[string]data.Problem{}
+	byID := map[string]data.Problem{}
+	for _, p := range candidates {
+		byTitle[p.Title] = p
+		byID[p.ID] = p
+	}
+
+	// pointers tell "missing" apart from zero values
+	var raw []struct {
+		Title  *string   `json:"title"`
+		Number *int      `json:"number"`
+		Topics *[]string `json:"topics"`
+		Reason *string   `json:"reason"`
+	}
+
+	dec := json.NewDecoder(bytes.NewReader([]byte(text)))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&raw); err != nil {
+		return nil, &SchemaError{Reason: err.Error(), Raw: text}
+	}
+	if dec.More() {
+		return nil, &SchemaError{Reason: "trailing data after JSON array", Raw: text}
+	}
+
+	if len(raw) == 0 || len(raw) > suggestionCount {
+		return nil, &SchemaError{
+			Reason: fmt.Sprintf("expected 1-%d suggestions, got %d", suggestionCount, len(raw)),
+			Raw:    text,
+		}
+	}
+
+	out := make([]data.AISuggestion, 0, len(raw))
+	seen := map[string]bool{}
+	for i, r := range raw {
+		switch {
+		case r.Title == nil || strings.TrimSpace(*r.Title) == "":
+			return nil, &SchemaError{Reason: fmt.Sprintf("item %d: missing title", i), Raw: text}
+		case r.Number == nil || *r.Number <= 0:
+			return nil, &SchemaError{Reason: fmt.Sprintf("item %d: missing or invalid number", i), Raw: text}
+		case r.Topics == nil:
+			return nil, &SchemaError{Reason: fmt.Sprintf("item %d: missing topics", i), Raw: text}
+		case r.Reason == nil || strings.TrimSpace(*r.Reason) == "":
+			return nil, &SchemaError{Reason: fmt.Sprintf("item %d: missing reason", i), Raw: text}
+		}
+
+		p, ok := byTitle[strings.TrimSpace(*r.Title)]
+		if !ok {
+			p, ok = byID[fmt.Sprint(*r.Number)]
+		}
+		if !ok {
+			return nil, &SchemaError{
+				Reason: fmt.Sprintf("item %d: %d %q is not one of the candidates", i, *r.Number, *r.Title),
+				Raw:    text,
+			}
+		}
+
+		if seen[p.ID] {
+			continue // the model repeated a pick; keep its first reason
+		}
+		seen[p.ID] = true
+
+		number, _ := strconv.Atoi(p.ID)
+		out = append(out, data.AISuggestion{
+			Title:  p.Title,
+			Number: number,
+			Topics: *r.Topics,
+			Reason: strings.TrimSpace(*r.Reason),
+		})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,30 @@
+package gemini
+
+import (
+	"testing"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+func TestParseSuggestionsDropsDuplicates(t *testing.T) {
+	candidates := []data.Problem{
+		{ID: "1", Title: "Two Sum", Difficulty: "Easy"},
+		{ID: "15", Title: "3Sum", Difficulty: "Medium"},
+	}
+	text := `[
+		{"title": "Two Sum", "number": 1, "topics": ["Array"], "reason": "warm-up"},
+		{"title": "3Sum", "number": 15, "topics": ["Array"], "reason": "next step"},
+		{"title": "Two Sum", "number": 99, "topics": ["Array"], "reason": "again"}
+	]`
+
+	got, err := parseSuggestions(text, candidates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d suggestions, want 2: %+v", len(got), got)
+	}
+	if got[0].Number != 1 || got[0].Reason != "warm-up" || got[1].Number != 15 {
+		t.Errorf("unexpected suggestions %+v", got)
+	}
+}
@@ -0,0 +1,58 @@
+package gemini
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates
+var defaultTemplates embed.FS
+
+// PromptTemplateName is the suggestion prompt's file name, both embedded
+// and as an override in <templates dir>/prompts/.
+const PromptTemplateName = "suggest.tmpl"
+
+var promptFuncs = template.FuncMap{
+	"join":  strings.Join,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// PromptTemplatePath is where a user override of the suggestion prompt lives.
+func PromptTemplatePath(templatesDir string) string {
+	return filepath.Join(templatesDir, "prompts", PromptTemplateName)
+}
+
+// PromptTemplateSource returns the template text in effect and where it came
+// from: the override in templatesDir if present, the embedded default otherwise.
+func PromptTemplateSource(templatesDir string) (src, origin string, err error) {
+	if templatesDir != "" {
+		path := PromptTemplatePath(templatesDir)
+		b, err := os.ReadFile(path)
+		if err == nil {
+			return string(b), path, nil
+		}
+		if !os.IsNotExist(err) {
+			return "", "", err
+		}
+	}
+	b, err := defaultTemplates.ReadFile("templates/" + PromptTemplateName)
+	return string(b), "built-in", err
+}
+
+// LoadPromptTemplate parses the suggestion prompt template in effect.
+func LoadPromptTemplate(templatesDir string) (*template.Template, error) {
+	src, origin, err := PromptTemplateSource(templatesDir)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := template.New(PromptTemplateName).Funcs(promptFuncs).Option("missingkey=error").Parse(src)
+	if err != nil {
+		return nil, fmt.Errorf("prompt template %s: %w", origin, err)
+	}
+	return tmpl, nil
+}
@@ -0,0 +1,267 @@
+package gemini
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/recommend"
+)
+
+// DefaultToolRounds caps how many times the model may call tools before
+// it has to answer.
+const DefaultToolRounds = 3
+
+const (
+	defaultSearchLimit = 10
+	maxSearchLimit     = 20
+)
+
+// Tool is a function the model may call; Run executes it locally.
+type Tool struct {
+	Name        string
+	Description string
+	Parameters  map[string]interface{} // OpenAPI subset, nil = no arguments
+	Run         func(args map[string]interface{}) map[string]interface{}
+}
+
+// Toolbox is the set of tools offered during suggest. It remembers which
+// eligible problems the tools surfaced so they may be suggested too.
+type Toolbox struct {
+	Tools     []Tool
+	MaxRounds int
+
+	found []data.Problem
+	seen  map[string]bool
+}
+
+// CatalogTools exposes the catalog and the user's history to the model:
+// search_catalog, get_solved_summary and get_problem. Searches only ever
+// return unsolved, unblocked problems that match filter.
+func CatalogTools(catalog *data.Catalog, solved []data.SolvedProblem, history []data.HistoryEntry, skills recommend.Skills, filter data.Filter) *Toolbox {
+	tb := &Toolbox{MaxRounds: DefaultToolRounds, seen: map[string]bool{}}
+
+	solvedIDs := map[string]bool{}
+	for _, s := range solved {
+		solvedIDs[s.ID] = true
+	}
+
+	tb.Tools = []Tool{
+		{
+			Name:        "search_catalog",
+			Description: "Find unsolved problems the user may be suggested, best matches first. Results already respect the user's filters.",
+			Parameters: map[string]interface{}{
+				"type": "OBJECT",
+				"properties": map[string]interface{}{
+					"topic":      map[string]interface{}{"type": "STRING", "description": "Topic tag, e.g. Graph"},
+					"difficulty": map[string]interface{}{"type": "STRING", "enum": []string{"Easy", "Medium", "Hard"}},
+					"limit":      map[string]interface{}{"type": "INTEGER", "description": fmt.Sprintf("At most %d", maxSearchLimit)},
+				},
+			},
+			Run: func(args map[string]interface{}) map[string]interface{} {
+				topic := stringArg(args, "topic")
+				difficulty := stringArg(args, "difficulty")
+				limit := intArg(args, "limit", defaultSearchLimit)
+				limit = min(max(limit, 1), maxSearchLimit)
+
+				results := []map[string]interface{}{}
+				for _, p := range recommend.Local(catalog, solved, history, skills, filter, catalog.Len()) {
+					if len(results) == limit {
+						break
+					}
+					if difficulty != "" && !strings.EqualFold(p.Difficulty, difficulty) {
+						continue
+					}
+					if topic != "" && !(data.Filter{Topic: topic}).Match(p) {
+						continue
+					}
+					tb.remember(p)
+					results = append(results, problemSummary(p))
+				}
+				return map[string]interface{}{"problems": results}
+			},
+		},
+		{
+			Name:        "get_solved_summary",
+			Description: "Summarize what the user has solved: totals, per-topic counts, recent solves, streak, weak topics and feedback on skipped suggestions.",
+			Run: func(map[string]interface{}) map[string]interface{} {
+				return map[string]interface{}{
+					"summary":    summarizeSolved(catalog, solved, defaultRecentSolved),
+					"streak":     recommend.Streak(solved),
+					"weakTopics": recommend.WeakTopics(catalog, solved),
+					"feedback":   recommend.LearnFeedback(catalog, history).Describe(),
+				}
+			},
+		},
+		{
+			Name:        "get_problem",
+			Description: "Details of one problem by its ID, including whether the user solved it and whether it may be suggested.",
+			Parameters: map[string]interface{}{
+				"type": "OBJECT",
+				"properties": map[string]interface{}{
+					"id": map[string]interface{}{"type": "STRING", "description": "Problem ID, e.g. 1"},
+				},
+				"required": []string{"id"},
+			},
+			Run: func(args map[string]interface{}) map[string]interface{} {
+				p, ok := catalog.Lookup(stringArg(args, "id"))
+				if !ok {
+					return map[string]interface{}{"error": "no such problem"}
+				}
+
+				out := problemSummary(p)
+				out["solved"] = solvedIDs[p.ID]
+				out["likes"] = p.Likes
+				similar := []string{}
+				for _, s := range p.SimilarQuestions {
+					similar = append(similar, s.Title)
+				}
+				out["similar"] = similar
+
+				eligible := false
+				for _, e := range recommend.Local(catalog, solved, history, skills, filter, catalog.Len()) {
+					if e.ID == p.ID {
+						eligible = true
+						break
+					}
+				}
+				out["canSuggest"] = eligible
+				if eligible {
+					tb.remember(p)
+				}
+				return out
+			},
+		},
+	}
+	return tb
+}
+
+// Found returns the eligible problems the tools returned so far.
+func (tb *Toolbox) Found() []data.Problem {
+	return tb.found
+}
+
+func (tb *Toolbox) remember(p data.Problem) {
+	if !tb.seen[p.ID] {
+		tb.seen[p.ID] = true
+		tb.found = append(tb.found, p)
+	}
+}
+
+// declarations is the "tools" entry of a request.
+func (tb *Toolbox) declarations() []map[string]interface{} {
+	decls := make([]map[string]interface{}, 0, len(tb.Tools))
+	for _, t := range tb.Tools {
+		d := map[string]interface{}{"name": t.Name, "description": t.Description}
+		if t.Parameters != nil {
+			d["parameters"] = t.Parameters
+		}
+		decls = append(decls, d)
+	}
+	return []map[string]interface{}{{"functionDeclarations": decls}}
+}
+
+// call runs the named tool; unknown names are reported back to the model.
+func (tb *Toolbox) call(fc FunctionCall) map[string]interface{} {
+	for _, t := range tb.Tools {
+		if t.Name == fc.Name {
+			return t.Run(fc.Args)
+		}
+	}
+	return map[string]interface{}{"error": "unknown function " + fc.Name}
+}
+
+// useTools lets the model call tools for up to MaxRounds rounds. The
+// results are appended to the prompt, and the problems they surfaced are
+// added to the candidates, for the final structured request.
+func (c *Client) useTools(ctx context.Context, prompt string, candidates []data.Problem) (string, []data.Problem, error) {
+	tb := c.Tools
+	contents := []Content{{Role: "user", Parts: []Part{{Text: prompt + toolsNote}}}}
+	results := []string{}
+
+	for round := 1; round <= tb.MaxRounds; round++ {
+		gResp, err := c.generate(ctx, map[string]interface{}{
+			"contents": contents,
+			"tools":    tb.declarations(),
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		if len(gResp.Candidates) == 0 {
+			break
+		}
+
+		turn := gResp.Candidates[0].Content
+		answers := []Part{}
+		for _, part := range turn.Parts {
+			if part.FunctionCall == nil {
+				continue
+			}
+			out := tb.call(*part.FunctionCall)
+			answers = append(answers, Part{FunctionResponse: &FunctionResponse{Name: part.FunctionCall.Name, Response: out}})
+
+			args := []byte("{}")
+			if len(part.FunctionCall.Args) > 0 {
+				args, _ = json.Marshal(part.FunctionCall.Args)
+			}
+			res, _ := json.Marshal(out)
+			results = append(results, fmt.Sprintf("%s(%s) -> %s", part.FunctionCall.Name, args, res))
+		}
+		if len(answers) == 0 {
+			break // the model has what it needs
+		}
+
+		turn.Role = "model"
+		contents = append(contents, turn, Content{Role: "user", Parts: answers})
+	}
+
+	if len(results) == 0 {
+		return prompt, candidates, nil
+	}
+
+	prompt += "\nTOOL_RESULTS (what you looked up; problems with canSuggest or from search_catalog may be picked too):\n" +
+		strings.Join(results, "\n") + "\n"
+
+	merged := append([]data.Problem(nil), candidates...)
+	inPool := map[string]bool{}
+	for _, p := range candidates {
+		inPool[p.ID] = true
+	}
+	for _, p := range tb.Found() {
+		if !inPool[p.ID] {
+			merged = append(merged, p)
+		}
+	}
+	return prompt, merged, nil
+}
+
+const toolsNote = `
+You may call the tools to look up the user's history or search for better
+matches before answering. When you are done, reply with a short plain-text
+note; the final picks are requested separately.`
+
+func problemSummary(p data.Problem) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         p.ID,
+		"title":      p.Title,
+		"difficulty": p.Difficulty,
+		"topics":     p.Topics(),
+		"acRate":     p.AcRate,
+		"paidOnly":   p.PaidOnly,
+	}
+}
+
+func stringArg(args map[string]interface{}, name string) string {
+	s, _ := args[name].(string)
+	return strings.TrimSpace(s)
+}
+
+// intArg reads a number argument; JSON numbers arrive as float64.
+func intArg(args map[string]interface{}, name string, def int) int {
+	if f, ok := args[name].(float64); ok {
+		return int(f)
+	}
+	return def
+}
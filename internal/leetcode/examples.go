@@ -0,0 +1,127 @@
+// Package leetcode reads problem details the bundled catalog lacks from
+// leetcode.com's public GraphQL API.
+package leetcode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+const endpoint = "https://leetcode.com/graphql"
+
+const examplesQuery = `query questionExamples($titleSlug: String!) {
+  question(titleSlug: $titleSlug) { exampleTestcases metaData content }
+}`
+
+// Client fetches from the LeetCode GraphQL endpoint.
+type Client struct {
+	URL  string
+	HTTP *http.Client
+}
+
+func NewClient() *Client {
+	return &Client{URL: endpoint, HTTP: &http.Client{}}
+}
+
+// Examples returns the sample cases of the problem with the given slug,
+// pairing the inputs of exampleTestcases with the outputs of the statement.
+// Problems without plain function parameters (design problems) have none.
+func (c *Client) Examples(ctx context.Context, slug string) ([]data.Example, error) {
+	body, _ := json.Marshal(map[string]any{
+		"query":     examplesQuery,
+		"variables": map[string]string{"titleSlug": slug},
+	})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Referer", "https://leetcode.com/problems/"+slug+"/")
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("leetcode: %s", resp.Status)
+	}
+
+	var out struct {
+		Data struct {
+			Question *struct {
+				ExampleTestcases string `json:"exampleTestcases"`
+				MetaData         string `json:"metaData"`
+				Content          string `json:"content"`
+			} `json:"question"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, fmt.Errorf("leetcode: invalid response: %w", err)
+	}
+	q := out.Data.Question
+	if q == nil {
+		return nil, fmt.Errorf("leetcode: no problem %q", slug)
+	}
+	return parseExamples(q.ExampleTestcases, q.MetaData, q.Content), nil
+}
+
+// exampleOutput matches "<strong>Output:</strong> [0,1]" in both the old
+// <pre> statements and the newer <span class="example-io"> ones.
+var (
+	exampleOutput = regexp.MustCompile(`<strong>Output:?\s*</strong>:?\s*(.*?)\s*(?:</p>|</pre>|$)`)
+	htmlTag       = regexp.MustCompile(`<[^>]+>`)
+)
+
+// parseExamples splits testcases (one argument value per line, examples
+// back to back) by the parameter count of metaData and names each value.
+func parseExamples(testcases, metaData, content string) []data.Example {
+	var meta struct {
+		Params []struct {
+			Name string `json:"name"`
+		} `json:"params"`
+	}
+	if json.Unmarshal([]byte(metaData), &meta) != nil || len(meta.Params) == 0 {
+		return nil
+	}
+
+	values := strings.Split(strings.TrimSpace(testcases), "\n")
+	if len(values) < len(meta.Params) || len(values)%len(meta.Params) != 0 {
+		return nil
+	}
+
+	outputs := []string{}
+	for _, line := range strings.Split(content, "\n") {
+		if m := exampleOutput.FindStringSubmatch(line); m != nil {
+			outputs = append(outputs, strings.TrimSpace(html.UnescapeString(htmlTag.ReplaceAllString(m[1], ""))))
+		}
+	}
+
+	examples := []data.Example{}
+	for i := 0; i+len(meta.Params) <= len(values) && len(examples) < len(outputs); i += len(meta.Params) {
+		args := make([]string, len(meta.Params))
+		for j, p := range meta.Params {
+			args[j] = p.Name + " = " + strings.TrimSpace(values[i+j])
+		}
+		examples = append(examples, data.Example{
+			Input:  strings.Join(args, ", "),
+			Output: outputs[len(examples)],
+		})
+	}
+	return examples
+}
@@ -0,0 +1,74 @@
+package leetcode
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+const twoSumMeta = `{"name":"twoSum","params":[{"name":"nums","type":"integer[]"},{"name":"target","type":"integer"}],"return":{"type":"integer[]"}}`
+
+func TestParseExamples(t *testing.T) {
+	content := "<p><strong class=\"example\">Example 1:</strong></p>\n" +
+		"<pre>\n<strong>Input:</strong> nums = [2,7,11,15], target = 9\n<strong>Output:</strong> [0,1]\n" +
+		"<strong>Explanation:</strong> Because nums[0] + nums[1] == 9, we return [0, 1].\n</pre>\n" +
+		"<p><strong>Input:</strong> <span class=\"example-io\">nums = [3,2,4], target = 6</span></p>\n" +
+		"<p><strong>Output:</strong> <span class=\"example-io\">[1,2]</span></p>\n"
+
+	got := parseExamples("[2,7,11,15]\n9\n[3,2,4]\n6", twoSumMeta, content)
+	want := []data.Example{
+		{Input: "nums = [2,7,11,15], target = 9", Output: "[0,1]"},
+		{Input: "nums = [3,2,4], target = 6", Output: "[1,2]"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d examples, want %d: %v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("example %d = %+v, want %+v", i+1, got[i], want[i])
+		}
+	}
+}
+
+func TestParseExamplesDesignProblem(t *testing.T) {
+	meta := `{"classname":"LRUCache","methods":[{"name":"get","params":[{"name":"key","type":"integer"}]}]}`
+	if got := parseExamples(`["LRUCache","get"]`+"\n[[2],[1]]", meta, "<strong>Output:</strong> [null,-1]"); got != nil {
+		t.Errorf("design problem: got %v, want none", got)
+	}
+}
+
+func TestClientExamples(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables map[string]string `json:"variables"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.Variables["titleSlug"] != "two-sum" {
+			w.Write([]byte(`{"data":{"question":null}}`))
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"question": map[string]string{
+			"exampleTestcases": "[3,3]\n6",
+			"metaData":         twoSumMeta,
+			"content":          "<strong>Output:</strong> [0,1]\n",
+		}}})
+	}))
+	defer srv.Close()
+
+	c := &Client{URL: srv.URL, HTTP: srv.Client()}
+	got, err := c.Examples(context.Background(), "two-sum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Input != "nums = [3,3], target = 6" || got[0].Output != "[0,1]" {
+		t.Errorf("got %+v", got)
+	}
+
+	if _, err := c.Examples(context.Background(), "no-such-problem"); err == nil {
+		t.Error("unknown slug: want an error")
+	}
+}
@@ -0,0 +1,72 @@
+package recommend
+
+import (
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+// Candidates builds the pool the AI is allowed to choose from: the best
+// unsolved problems matching filter (see Local), spread over topics so a
+// single weak topic cannot fill the whole pool.
+func Candidates(
+	catalog *data.Catalog,
+	solved []data.SolvedProblem,
+	history []data.HistoryEntry,
+	skills Skills,
+	filter data.Filter,
+	n int,
+) []data.Problem {
+
+	ranked := Local(catalog, solved, history, skills, filter, catalog.Len())
+
+	weak := map[string]bool{}
+	for _, t := range WeakTopics(catalog, solved) {
+		weak[t] = true
+	}
+
+	perTopic := n / 3
+	if perTopic < 1 {
+		perTopic = 1
+	}
+
+	pool := []data.Problem{}
+	picked := map[string]bool{}
+	topicUse := map[string]int{}
+
+	for _, p := range ranked {
+		if len(pool) >= n {
+			break
+		}
+		key := primaryTopic(p, weak)
+		if topicUse[key] >= perTopic {
+			continue
+		}
+		topicUse[key]++
+		picked[p.ID] = true
+		pool = append(pool, p)
+	}
+
+	// not enough variety: top up in rank order
+	for _, p := range ranked {
+		if len(pool) >= n {
+			break
+		}
+		if !picked[p.ID] {
+			pool = append(pool, p)
+		}
+	}
+
+	return pool
+}
+
+// primaryTopic is the weak topic a problem practices, or its first tag.
+func primaryTopic(p data.Problem, weak map[string]bool) string {
+	for _, t := range p.TopicTags {
+		if weak[t.Name] {
+			return t.Name
+		}
+	}
+	if len(p.TopicTags) == 0 {
+		return ""
+	}
+	return p.TopicTags[0].Name
+}
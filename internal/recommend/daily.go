@@ -0,0 +1,63 @@
+package recommend
+
+import (
+	"hash/fnv"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+// DefaultRotation is the daily difficulty cycle when a team sets none.
+var DefaultRotation = []string{"Easy", "Medium", "Medium", "Easy", "Medium", "Hard", "Medium"}
+
+// Daily picks the problem of the day for seed (usually the team name).
+// It depends only on seed, day, the list and the catalog, so everyone
+// with the same inputs gets the same problem without coordinating.
+// The difficulty cycles through rotation; list restricts the choice to
+// those problem IDs, otherwise free catalog problems are used.
+func Daily(catalog *data.Catalog, list, rotation []string, seed string, day time.Time) (data.Problem, bool) {
+	if len(rotation) == 0 {
+		rotation = DefaultRotation
+	}
+	date := day.Format("2006-01-02")
+	// days since the epoch of the calendar date, independent of time zone
+	d, _ := time.Parse("2006-01-02", date)
+	n := int(d.Unix() / 86400)
+	difficulty := rotation[(n%len(rotation)+len(rotation))%len(rotation)] // dates before 1970 count down
+
+	all := []data.Problem{}
+	if len(list) > 0 {
+		for _, id := range list {
+			if p, ok := catalog.ByID(id); ok {
+				all = append(all, p)
+			}
+		}
+	} else {
+		for _, p := range catalog.Problems {
+			if !p.PaidOnly {
+				all = append(all, p)
+			}
+		}
+		// catalog order may differ between refreshes; IDs don't
+		sort.Slice(all, func(i, j int) bool { return idLess(all[i].ID, all[j].ID) })
+	}
+
+	pool := []data.Problem{}
+	for _, p := range all {
+		if strings.EqualFold(p.Difficulty, difficulty) {
+			pool = append(pool, p)
+		}
+	}
+	if len(pool) == 0 {
+		pool = all // the list has no problem of today's difficulty
+	}
+	if len(pool) == 0 {
+		return data.Problem{}, false
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(seed + "|" + date))
+	return pool[h.Sum64()%uint64(len(pool))], true
+}
@@ -0,0 +1,98 @@
+package recommend
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+// difficultyRank orders difficulties from easiest to hardest.
+var difficultyRank = map[string]int{"Easy": 1, "Medium": 2, "Hard": 3}
+
+// Feedback is what the user's skip reasons say about their preferences.
+type Feedback struct {
+	TooHard       map[string]int // difficulty -> times skipped as too hard
+	NotInterested map[string]int // topic -> times skipped as not interesting
+}
+
+// LearnFeedback aggregates skip reasons from history.
+func LearnFeedback(catalog *data.Catalog, history []data.HistoryEntry) Feedback {
+	fb := Feedback{TooHard: map[string]int{}, NotInterested: map[string]int{}}
+
+	for _, h := range history {
+		p, ok := catalog.ByID(h.ID)
+		if !ok {
+			continue
+		}
+		switch h.SkipReason {
+		case data.SkipTooHard:
+			fb.TooHard[p.Difficulty]++
+		case data.SkipNotInterested:
+			for _, t := range p.TopicTags {
+				fb.NotInterested[t.Name]++
+			}
+		}
+	}
+
+	return fb
+}
+
+// Penalty lowers the score of problems resembling rejected ones: at least
+// as hard as something skipped as too hard, more so the further above it,
+// or in topics the user skipped.
+func (f Feedback) Penalty(p data.Problem) float64 {
+	penalty := 0.0
+
+	rank, ok := difficultyRank[p.Difficulty]
+	if !ok {
+		rank = difficultyRank["Medium"] // unknown: assume the middle
+	}
+	for d, n := range f.TooHard {
+		skipped, ok := difficultyRank[d]
+		if ok && rank >= skipped {
+			penalty += 0.75 * float64(n) * float64(rank-skipped+1)
+		}
+	}
+
+	for _, t := range p.TopicTags {
+		penalty += 0.5 * float64(f.NotInterested[t.Name])
+	}
+
+	return penalty
+}
+
+// Describe summarizes the feedback for the AI prompt, or "" if there is none.
+func (f Feedback) Describe() string {
+	lines := []string{}
+
+	if len(f.TooHard) > 0 {
+		parts := []string{}
+		for _, d := range []string{"Easy", "Medium", "Hard"} {
+			if n := f.TooHard[d]; n > 0 {
+				parts = append(parts, fmt.Sprintf("%s x%d", d, n))
+			}
+		}
+		lines = append(lines, "Skipped as too hard: "+strings.Join(parts, ", "))
+	}
+
+	if len(f.NotInterested) > 0 {
+		topics := make([]string, 0, len(f.NotInterested))
+		for t := range f.NotInterested {
+			topics = append(topics, t)
+		}
+		sort.Slice(topics, func(i, j int) bool {
+			if f.NotInterested[topics[i]] != f.NotInterested[topics[j]] {
+				return f.NotInterested[topics[i]] > f.NotInterested[topics[j]]
+			}
+			return topics[i] < topics[j]
+		})
+		if len(topics) > 5 {
+			topics = topics[:5]
+		}
+		lines = append(lines, "Not interested in: "+strings.Join(topics, ", "))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,90 @@
+package recommend
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+func feedbackCatalog() *data.Catalog {
+	topic := []data.TopicTag{{Name: "Array"}}
+	return data.NewCatalog([]data.Problem{
+		{ID: "1", Title: "E1", Difficulty: "Easy", TopicTags: topic},
+		{ID: "2", Title: "M1", Difficulty: "Medium", TopicTags: topic},
+		{ID: "3", Title: "H1", Difficulty: "Hard", TopicTags: topic},
+		{ID: "4", Title: "E2", Difficulty: "Easy", TopicTags: topic},
+		{ID: "5", Title: "M2", Difficulty: "Medium", TopicTags: topic},
+		{ID: "6", Title: "H2", Difficulty: "Hard", TopicTags: topic},
+	})
+}
+
+func TestLearnFeedback(t *testing.T) {
+	catalog := feedbackCatalog()
+	history := []data.HistoryEntry{
+		{ID: "1", SkipReason: data.SkipTooHard},
+		{ID: "3", SkipReason: data.SkipTooHard},
+		{ID: "2", SkipReason: data.SkipNotInterested},
+		{ID: "5", SkipReason: data.SkipSeenIt},
+		{ID: "404", SkipReason: data.SkipTooHard}, // not in the catalog
+	}
+
+	fb := LearnFeedback(catalog, history)
+	if fb.TooHard["Easy"] != 1 || fb.TooHard["Hard"] != 1 || len(fb.TooHard) != 2 {
+		t.Errorf("TooHard = %v", fb.TooHard)
+	}
+	if fb.NotInterested["Array"] != 1 || len(fb.NotInterested) != 1 {
+		t.Errorf("NotInterested = %v", fb.NotInterested)
+	}
+}
+
+func TestPenaltyFavoursEasierAfterTooHardEasy(t *testing.T) {
+	fb := Feedback{TooHard: map[string]int{"Easy": 1}}
+	easy := fb.Penalty(data.Problem{Difficulty: "Easy"})
+	medium := fb.Penalty(data.Problem{Difficulty: "Medium"})
+	hard := fb.Penalty(data.Problem{Difficulty: "Hard"})
+	if !(easy > 0 && easy < medium && medium < hard) {
+		t.Errorf("penalties easy %.2f, medium %.2f, hard %.2f: want increasing", easy, medium, hard)
+	}
+
+	if p := fb.Penalty(data.Problem{Difficulty: "Unknown"}); p != medium {
+		t.Errorf("unknown difficulty penalty %.2f, want %.2f like Medium", p, medium)
+	}
+	if p := (Feedback{TooHard: map[string]int{"Medium": 1}}).Penalty(data.Problem{Difficulty: "Easy"}); p != 0 {
+		t.Errorf("Easy after a Medium skip: penalty %.2f, want 0", p)
+	}
+}
+
+func TestLocalRanksEasierAfterTooHardEasySkips(t *testing.T) {
+	catalog := feedbackCatalog()
+	now := time.Now()
+
+	// a 10-day streak of solves outside the catalog: Medium and Hard preferred
+	solved := []data.SolvedProblem{}
+	for d := 0; d < 10; d++ {
+		solved = append(solved, data.SolvedProblem{ID: fmt.Sprintf("s%d", d), Date: now.AddDate(0, 0, -d).Format("2006-01-02")})
+	}
+	skills := RateSkills(catalog, solved, nil, now)
+
+	top := func(history []data.HistoryEntry) string {
+		picks := Local(catalog, solved, history, skills, data.Filter{}, 1)
+		if len(picks) == 0 {
+			t.Fatal("no picks")
+		}
+		return picks[0].Difficulty
+	}
+
+	if got := top(nil); got == "Easy" {
+		t.Fatalf("without feedback the top pick is already Easy")
+	}
+
+	// the skipped problems are blocked, the other Easy one is not
+	history := []data.HistoryEntry{}
+	for i := 0; i < 4; i++ {
+		history = append(history, data.HistoryEntry{ID: "1", Date: now.Format("2006-01-02"), SkipReason: data.SkipTooHard})
+	}
+	if got := top(history); got != "Easy" {
+		t.Errorf("after Easy too-hard skips the top pick is %s, want Easy", got)
+	}
+}
@@ -0,0 +1,108 @@
+package recommend
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+// readinessWeight rewards problems whose topics' prerequisites are covered.
+const readinessWeight = 1.5
+
+// Local ranks unsolved catalog problems without calling any AI.
+// It returns at most n problems, best first.
+func Local(
+	catalog *data.Catalog,
+	solved []data.SolvedProblem,
+	history []data.HistoryEntry,
+	skills Skills,
+	filter data.Filter,
+	n int,
+) []data.Problem {
+
+	// recent suggestions, active snoozes and "seen it" skips
+	block := data.BlockedIDs(history, time.Now())
+	for _, s := range solved {
+		block[s.ID] = true
+	}
+
+	feedback := LearnFeedback(catalog, history)
+
+	weak := map[string]bool{}
+	for _, t := range WeakTopics(catalog, solved) {
+		weak[t] = true
+	}
+
+	// topics whose prerequisites are covered come first
+	covered := coveredTopics(catalog, topicSolves(catalog, solved))
+
+	preferred := map[string]bool{}
+	for _, d := range PreferredDifficulties(Streak(solved)) {
+		preferred[d] = true
+	}
+
+	type scored struct {
+		Problem data.Problem
+		Score   float64
+	}
+
+	candidates := []scored{}
+	for _, p := range catalog.Problems {
+		if block[p.ID] || !filter.Match(p) {
+			continue
+		}
+		candidates = append(candidates, scored{p, score(p, weak, preferred, skills) + readinessWeight*readiness(p, covered) - feedback.Penalty(p)})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return idLess(candidates[i].Problem.ID, candidates[j].Problem.ID)
+	})
+
+	if len(candidates) > n {
+		candidates = candidates[:n]
+	}
+
+	out := make([]data.Problem, 0, len(candidates))
+	for _, c := range candidates {
+		out = append(out, c.Problem)
+	}
+	return out
+}
+
+// score favours weak topics, the streak's difficulty band and problems at
+// the edge of the user's skill, then breaks ties with whatever quality
+// signals the catalog carries.
+func score(p data.Problem, weak, preferred map[string]bool, skills Skills) float64 {
+	s := 0.0
+	for _, t := range p.TopicTags {
+		if weak[t.Name] {
+			s += 2
+		}
+	}
+	if preferred[p.Difficulty] {
+		s += 2
+	}
+	s += 2 * skills.Edge(p)
+	if p.PaidOnly {
+		s -= 1
+	}
+	s += p.AcRate / 100
+	s += p.Frequency / 100
+	s += p.LikeRatio()
+	return s
+}
+
+// idLess orders frontend IDs numerically when possible.
+func idLess(a, b string) bool {
+	ai, errA := strconv.Atoi(a)
+	bi, errB := strconv.Atoi(b)
+	if errA == nil && errB == nil {
+		return ai < bi
+	}
+	return a < b
+}
@@ -0,0 +1,41 @@
+package recommend
+
+import (
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+	"github.com/chhand2808/goleet/internal/similar"
+)
+
+// NextSteps suggests up to n unsolved problems to try after solving from:
+// its closest offline neighbors, never jumping more than one difficulty up.
+func NextSteps(
+	catalog *data.Catalog,
+	solved []data.SolvedProblem,
+	history []data.HistoryEntry,
+	model *similar.Model,
+	from data.Problem,
+	n int,
+) []data.Problem {
+
+	block := data.BlockedIDs(history, time.Now())
+	for _, s := range solved {
+		block[s.ID] = true
+	}
+
+	out := []data.Problem{}
+	for _, m := range model.Similar(from.ID, similar.TableSize) {
+		if len(out) == n {
+			break
+		}
+		p, ok := catalog.ByID(m.ID)
+		if !ok || block[p.ID] {
+			continue
+		}
+		if difficultyRank[p.Difficulty] > difficultyRank[from.Difficulty]+1 {
+			continue
+		}
+		out = append(out, p)
+	}
+	return out
+}
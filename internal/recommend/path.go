@@ -0,0 +1,239 @@
+package recommend
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+// Prerequisites maps a topic tag to the topics worth covering first.
+// Tags missing from the map have no prerequisites.
+var Prerequisites = map[string][]string{
+	"Hash Table":            {"Array"},
+	"Sorting":               {"Array"},
+	"Two Pointers":          {"Array"},
+	"Prefix Sum":            {"Array"},
+	"Matrix":                {"Array"},
+	"Simulation":            {"Array"},
+	"Stack":                 {"Array"},
+	"Queue":                 {"Array"},
+	"Sliding Window":        {"Two Pointers", "Hash Table"},
+	"Binary Search":         {"Array", "Sorting"},
+	"Greedy":                {"Sorting"},
+	"Counting":              {"Hash Table"},
+	"Hash Function":         {"Hash Table"},
+	"Design":                {"Hash Table"},
+	"Data Stream":           {"Design"},
+	"Iterator":              {"Design"},
+	"Monotonic Stack":       {"Stack"},
+	"Monotonic Queue":       {"Queue", "Sliding Window"},
+	"Doubly-Linked List":    {"Linked List"},
+	"Tree":                  {"Recursion"},
+	"Binary Tree":           {"Tree"},
+	"Binary Search Tree":    {"Binary Tree", "Binary Search"},
+	"Ordered Set":           {"Binary Search Tree"},
+	"Heap (Priority Queue)": {"Binary Tree", "Sorting"},
+	"Trie":                  {"Tree", "String"},
+	"Depth-First Search":    {"Recursion", "Stack"},
+	"Breadth-First Search":  {"Queue"},
+	"Backtracking":          {"Recursion"},
+	"Divide and Conquer":    {"Recursion"},
+	"Merge Sort":            {"Divide and Conquer", "Sorting"},
+	"Quickselect":           {"Divide and Conquer", "Sorting"},
+	"Memoization":           {"Recursion", "Hash Table"},
+	"Dynamic Programming":   {"Memoization", "Array"},
+	"Game Theory":           {"Dynamic Programming"},
+	"Bit Manipulation":      {"Math"},
+	"Bitmask":               {"Bit Manipulation", "Dynamic Programming"},
+	"Number Theory":         {"Math"},
+	"Combinatorics":         {"Math"},
+	"Geometry":              {"Math"},
+	"Randomized":            {"Math"},
+	"Reservoir Sampling":    {"Randomized"},
+	"Rejection Sampling":    {"Randomized"},
+	"Graph":                 {"Depth-First Search", "Breadth-First Search"},
+	"Topological Sort":      {"Graph"},
+	"Union Find":            {"Graph"},
+	"Shortest Path":         {"Graph", "Heap (Priority Queue)"},
+	"Eulerian Circuit":      {"Graph"},
+	"String Matching":       {"String"},
+	"Rolling Hash":          {"String Matching", "Hash Function"},
+	"Bucket Sort":           {"Sorting", "Counting"},
+	"Counting Sort":         {"Sorting", "Counting"},
+	"Radix Sort":            {"Counting Sort"},
+	"Segment Tree":          {"Binary Tree", "Divide and Conquer", "Prefix Sum"},
+	"Binary Indexed Tree":   {"Prefix Sum", "Bit Manipulation"},
+	"Line Sweep":            {"Sorting", "Heap (Priority Queue)"},
+}
+
+// CoverSolves is how many solves in a topic count as having covered it
+// (fewer when the catalog has fewer problems in the topic).
+const CoverSolves = 3
+
+// Topic states on the learning path.
+const (
+	TopicCovered = "covered"
+	TopicReady   = "ready"  // every prerequisite covered
+	TopicLocked  = "locked" // some prerequisite still missing
+)
+
+// TopicStatus is where the user stands in one topic.
+type TopicStatus struct {
+	Topic   string
+	Solved  int
+	Needed  int      // solves that cover the topic
+	State   string   // TopicCovered, TopicReady or TopicLocked
+	Missing []string // uncovered prerequisites
+	Unlocks []string // topics that list this one as a prerequisite
+	Depth   int      // length of the longest prerequisite chain
+}
+
+// Curriculum returns the status of every topic in the catalog, roots first.
+func Curriculum(catalog *data.Catalog, solved []data.SolvedProblem) []TopicStatus {
+	solvedCount := topicSolves(catalog, solved)
+	covered := coveredTopics(catalog, solvedCount)
+
+	unlocks := map[string][]string{}
+	for topic, pre := range Prerequisites {
+		for _, p := range pre {
+			unlocks[p] = append(unlocks[p], topic)
+		}
+	}
+
+	out := []TopicStatus{}
+	for _, topic := range catalog.Topics() {
+		st := TopicStatus{
+			Topic:   topic,
+			Solved:  solvedCount[topic],
+			Needed:  coverNeeded(catalog, topic),
+			Unlocks: unlocks[topic],
+			Depth:   depth(topic, map[string]bool{}),
+		}
+		sort.Strings(st.Unlocks)
+		for _, p := range Prerequisites[topic] {
+			if !covered[p] {
+				st.Missing = append(st.Missing, p)
+			}
+		}
+		switch {
+		case covered[topic]:
+			st.State = TopicCovered
+		case len(st.Missing) == 0:
+			st.State = TopicReady
+		default:
+			st.State = TopicLocked
+		}
+		out = append(out, st)
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Depth != out[j].Depth {
+			return out[i].Depth < out[j].Depth
+		}
+		if len(out[i].Unlocks) != len(out[j].Unlocks) {
+			return len(out[i].Unlocks) > len(out[j].Unlocks)
+		}
+		return out[i].Topic < out[j].Topic
+	})
+	return out
+}
+
+// Chain returns topic and all its transitive prerequisites, each after the
+// topics it depends on.
+func Chain(topic string) []string {
+	out := []string{}
+	seen := map[string]bool{}
+	var visit func(t string)
+	visit = func(t string) {
+		if seen[t] {
+			return
+		}
+		seen[t] = true
+		for _, p := range Prerequisites[t] {
+			visit(p)
+		}
+		out = append(out, t)
+	}
+	visit(topic)
+	return out
+}
+
+// CanonicalTopic finds a catalog topic by case-insensitive name.
+func CanonicalTopic(catalog *data.Catalog, name string) (string, bool) {
+	for _, t := range catalog.Topics() {
+		if strings.EqualFold(t, name) {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+// readiness is the share of p's topics whose prerequisites are covered.
+func readiness(p data.Problem, covered map[string]bool) float64 {
+	if len(p.TopicTags) == 0 {
+		return 1
+	}
+	ready := 0
+	for _, t := range p.TopicTags {
+		ok := true
+		for _, pre := range Prerequisites[t.Name] {
+			if !covered[pre] {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			ready++
+		}
+	}
+	return float64(ready) / float64(len(p.TopicTags))
+}
+
+func topicSolves(catalog *data.Catalog, solved []data.SolvedProblem) map[string]int {
+	counts := map[string]int{}
+	for _, s := range solved {
+		if p, ok := catalog.ByID(s.ID); ok {
+			for _, t := range p.TopicTags {
+				counts[t.Name]++
+			}
+		}
+	}
+	return counts
+}
+
+// coveredTopics marks topics with enough solves. Prerequisites the catalog
+// has no problems for count as covered, so they never lock anything.
+func coveredTopics(catalog *data.Catalog, solvedCount map[string]int) map[string]bool {
+	topics := catalog.Topics()
+	for _, pre := range Prerequisites {
+		topics = append(topics, pre...)
+	}
+
+	covered := map[string]bool{}
+	for _, topic := range topics {
+		if solvedCount[topic] >= coverNeeded(catalog, topic) {
+			covered[topic] = true
+		}
+	}
+	return covered
+}
+
+func coverNeeded(catalog *data.Catalog, topic string) int {
+	return min(CoverSolves, len(catalog.ByTopic(topic)))
+}
+
+// depth is the longest prerequisite chain below topic (cycles are cut).
+func depth(topic string, visiting map[string]bool) int {
+	if visiting[topic] {
+		return 0
+	}
+	visiting[topic] = true
+	defer delete(visiting, topic)
+
+	d := 0
+	for _, p := range Prerequisites[topic] {
+		d = max(d, depth(p, visiting)+1)
+	}
+	return d
+}
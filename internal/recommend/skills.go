@@ -0,0 +1,184 @@
+package recommend
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+// Rating model: Elo with a K factor that shrinks as a topic gets more
+// games (Glicko-style confidence), problems rated by difficulty.
+const (
+	InitialRating = 1200.0
+
+	baseK       = 40.0
+	minKFactor  = 0.4  // K never drops below baseK*minKFactor
+	failedShare = 0.25 // a red test run is weak evidence compared to a solve
+	hintPenalty = 0.15 // per hint level used
+	slowPenalty = 0.3  // cap on the penalty for solving slower than expected
+	edgeMargin  = 100  // the "edge": problems this much above the user's rating
+	edgeWidth   = 300  // how fast the edge bonus falls off
+)
+
+// TrendWindow is how far back stats compare ratings for trend arrows.
+const TrendWindow = 7 * 24 * time.Hour
+
+var difficultyRating = map[string]float64{"Easy": 1200, "Medium": 1500, "Hard": 1800}
+
+// expectedSolve is a typical solve time per difficulty.
+var expectedSolve = map[string]time.Duration{
+	"Easy":   15 * time.Minute,
+	"Medium": 30 * time.Minute,
+	"Hard":   45 * time.Minute,
+}
+
+// Skill is the user's rating in one topic.
+type Skill struct {
+	Topic  string
+	Rating float64
+	Games  int
+	Trend  float64 // rating change over TrendWindow
+}
+
+// Skills maps topic name to rating.
+type Skills map[string]*Skill
+
+// ProblemRating places a problem on the rating scale: its difficulty,
+// nudged by the acceptance rate when the catalog knows it.
+func ProblemRating(p data.Problem) float64 {
+	r, ok := difficultyRating[p.Difficulty]
+	if !ok {
+		r = difficultyRating["Medium"]
+	}
+	if p.AcRate > 0 {
+		r += math.Max(-150, math.Min(150, (50-p.AcRate)*5))
+	}
+	return r
+}
+
+// game is one rated outcome on a problem.
+type game struct {
+	At      time.Time
+	Problem data.Problem
+	Score   float64 // 1 = clean solve ... 0 = failure
+	Weight  float64 // share of K
+}
+
+// RateSkills replays every attempt and solve in time order. A problem
+// stops counting once it is solved, so re-running passing tests does not
+// inflate ratings; solves without a passing test count as one game.
+func RateSkills(catalog *data.Catalog, solved []data.SolvedProblem, attempts []data.Attempt, now time.Time) Skills {
+	games := []game{}
+	won := map[string]bool{}
+
+	sorted := append([]data.Attempt(nil), attempts...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].At.Before(sorted[j].At) })
+
+	for _, a := range sorted {
+		p, ok := catalog.ByID(a.ID)
+		if !ok || won[a.ID] {
+			continue
+		}
+		if !a.Passed {
+			games = append(games, game{At: a.At, Problem: p, Score: 0, Weight: failedShare})
+			continue
+		}
+		won[a.ID] = true
+		games = append(games, game{At: a.At, Problem: p, Score: solveScore(p, a.Hints, time.Duration(a.SolveSeconds)*time.Second), Weight: 1})
+	}
+
+	for _, s := range solved {
+		p, ok := catalog.ByID(s.ID)
+		if !ok || won[s.ID] {
+			continue
+		}
+		at, err := time.ParseInLocation("2006-01-02", s.Date, now.Location())
+		if err != nil {
+			continue
+		}
+		games = append(games, game{At: at.Add(12 * time.Hour), Problem: p, Score: solveScore(p, s.Hints, 0), Weight: 1})
+	}
+	sort.SliceStable(games, func(i, j int) bool { return games[i].At.Before(games[j].At) })
+
+	skills := Skills{}
+	before := map[string]float64{} // rating when the trend window opened
+	since := now.Add(-TrendWindow)
+
+	for _, g := range games {
+		opponent := ProblemRating(g.Problem)
+		for _, t := range g.Problem.TopicTags {
+			sk, ok := skills[t.Name]
+			if !ok {
+				sk = &Skill{Topic: t.Name, Rating: InitialRating}
+				skills[t.Name] = sk
+			}
+			k := baseK * math.Max(minKFactor, 1/math.Sqrt(1+float64(sk.Games)/10))
+			expected := 1 / (1 + math.Pow(10, (opponent-sk.Rating)/400))
+			sk.Rating += k * g.Weight * (g.Score - expected)
+			sk.Games++
+
+			if g.At.Before(since) {
+				before[t.Name] = sk.Rating
+			}
+		}
+	}
+
+	for topic, sk := range skills {
+		start, ok := before[topic]
+		if !ok {
+			start = InitialRating // first rated inside the window
+		}
+		sk.Trend = sk.Rating - start
+	}
+	return skills
+}
+
+// solveScore is 1 for a clean, timely solve, less with hints or when slow.
+func solveScore(p data.Problem, hints int, took time.Duration) float64 {
+	s := 1 - hintPenalty*float64(hints)
+	if want, ok := expectedSolve[p.Difficulty]; ok && took > want {
+		s -= math.Min(slowPenalty, slowPenalty*(took.Seconds()/want.Seconds()-1))
+	}
+	return math.Max(0.1, s) // a solve is never a loss
+}
+
+// Rating returns the user's rating in topic, InitialRating if unrated.
+func (s Skills) Rating(topic string) float64 {
+	if sk, ok := s[topic]; ok {
+		return sk.Rating
+	}
+	return InitialRating
+}
+
+// Sorted returns the rated topics, strongest first.
+func (s Skills) Sorted() []Skill {
+	out := make([]Skill, 0, len(s))
+	for _, sk := range s {
+		out = append(out, *sk)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Rating != out[j].Rating {
+			return out[i].Rating > out[j].Rating
+		}
+		return out[i].Topic < out[j].Topic
+	})
+	return out
+}
+
+// Edge scores how well p sits just above the user's ability in its topics:
+// 1 right at the edge, falling to 0 edgeWidth away.
+func (s Skills) Edge(p data.Problem) float64 {
+	if len(p.TopicTags) == 0 {
+		return 0
+	}
+	ability := 0.0
+	for _, t := range p.TopicTags {
+		ability += s.Rating(t.Name)
+	}
+	ability /= float64(len(p.TopicTags))
+
+	gap := math.Abs(ProblemRating(p) - (ability + edgeMargin))
+	return math.Max(0, 1-gap/edgeWidth)
+}
@@ -0,0 +1,123 @@
+package recommend
+
+import (
+	"sort"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+// Streak computes the consecutive-day streak based on solved.json
+func Streak(solved []data.SolvedProblem) int {
+	if len(solved) == 0 {
+		return 0
+	}
+
+	// Sort a copy by date descending
+	sorted := append([]data.SolvedProblem(nil), solved...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Date > sorted[j].Date
+	})
+
+	format := "2006-01-02"
+	streak := 1
+
+	lastDate, err := time.Parse(format, sorted[0].Date)
+	if err != nil {
+		return 1
+	}
+
+	for i := 1; i < len(sorted); i++ {
+		curDate, err := time.Parse(format, sorted[i].Date)
+		if err != nil {
+			continue
+		}
+
+		diff := lastDate.Sub(curDate).Hours()
+
+		if diff <= 24 && diff >= 0 {
+			streak++
+			lastDate = curDate
+		} else {
+			break
+		}
+	}
+
+	return streak
+}
+
+// WeakTopics computes weak topics (least solved vs most available)
+func WeakTopics(catalog *data.Catalog, solved []data.SolvedProblem) []string {
+
+	// Count total availability per topic
+	totalCount := map[string]int{}
+	for _, p := range catalog.Problems {
+		for _, t := range p.TopicTags {
+			totalCount[t.Name]++
+		}
+	}
+
+	// Count solved per topic
+	solvedCount := map[string]int{}
+	for _, s := range solved {
+		p, ok := catalog.ByID(s.ID)
+		if !ok {
+			continue
+		}
+		for _, t := range p.TopicTags {
+			solvedCount[t.Name]++
+		}
+	}
+
+	// Calculate weakness score = solved / total
+	type pair struct {
+		Topic string
+		Score float64
+	}
+
+	scoreList := []pair{}
+	for topic, total := range totalCount {
+		solved := solvedCount[topic]
+		score := float64(solved) / float64(total) // low = weak
+		scoreList = append(scoreList, pair{topic, score})
+	}
+
+	// Sort by increasing score (weakest first); ties go to the topic with
+	// more problems available, then by name, so the result is stable
+	sort.Slice(scoreList, func(i, j int) bool {
+		if scoreList[i].Score != scoreList[j].Score {
+			return scoreList[i].Score < scoreList[j].Score
+		}
+		ti, tj := totalCount[scoreList[i].Topic], totalCount[scoreList[j].Topic]
+		if ti != tj {
+			return ti > tj
+		}
+		return scoreList[i].Topic < scoreList[j].Topic
+	})
+
+	// Pick top 3 weakest topics
+	limit := 3
+	if len(scoreList) < limit {
+		limit = len(scoreList)
+	}
+
+	weakTopics := []string{}
+	for i := 0; i < limit; i++ {
+		weakTopics = append(weakTopics, scoreList[i].Topic)
+	}
+
+	return weakTopics
+}
+
+// PreferredDifficulties mirrors the streak guidance given to the AI:
+// early streaks lean Easy, longer streaks move towards Medium/Hard.
+func PreferredDifficulties(streak int) []string {
+	switch {
+	case streak < 3:
+		return []string{"Easy"}
+	case streak < 10:
+		return []string{"Easy", "Medium"}
+	default:
+		return []string{"Medium", "Hard"}
+	}
+}
@@ -0,0 +1,106 @@
+package recommend
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	assets "github.com/chhand2808/goleet/data"
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+// benchData is the embedded catalog with every third problem solved.
+func benchData(b *testing.B) (*data.Catalog, []data.SolvedProblem) {
+	b.Helper()
+	catalog, err := data.DecodeCatalog(bytes.NewReader(assets.EmbeddedCatalog))
+	if err != nil {
+		b.Fatalf("decode embedded catalog: %v", err)
+	}
+	solved := []data.SolvedProblem{}
+	for i := 0; i < len(catalog.Problems); i += 3 {
+		p := catalog.Problems[i]
+		solved = append(solved, data.SolvedProblem{ID: p.ID, Title: p.Title, Date: "2026-01-01"})
+	}
+	return catalog, solved
+}
+
+func BenchmarkWeakTopics(b *testing.B) {
+	catalog, solved := benchData(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		WeakTopics(catalog, solved)
+	}
+}
+
+// BenchmarkWeakTopicsLinearScan runs WeakTopics as it was before the
+// catalog index: every solved ID scanned the whole catalog.
+func BenchmarkWeakTopicsLinearScan(b *testing.B) {
+	catalog, solved := benchData(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		weakTopicsLinear(catalog, solved)
+	}
+}
+
+func TestWeakTopicsMatchesLinearScan(t *testing.T) {
+	catalog, err := data.DecodeCatalog(bytes.NewReader(assets.EmbeddedCatalog))
+	if err != nil {
+		t.Fatal(err)
+	}
+	solved := []data.SolvedProblem{{ID: catalog.Problems[0].ID}, {ID: catalog.Problems[5].ID}, {ID: "no-such-id"}}
+
+	got, want := WeakTopics(catalog, solved), weakTopicsLinear(catalog, solved)
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func weakTopicsLinear(catalog *data.Catalog, solved []data.SolvedProblem) []string {
+	totalCount := map[string]int{}
+	for _, p := range catalog.Problems {
+		for _, t := range p.TopicTags {
+			totalCount[t.Name]++
+		}
+	}
+
+	solvedCount := map[string]int{}
+	for _, s := range solved {
+		for _, p := range catalog.Problems {
+			if p.ID == s.ID {
+				for _, t := range p.TopicTags {
+					solvedCount[t.Name]++
+				}
+			}
+		}
+	}
+
+	type pair struct {
+		Topic string
+		Score float64
+	}
+	scoreList := []pair{}
+	for topic, total := range totalCount {
+		scoreList = append(scoreList, pair{topic, float64(solvedCount[topic]) / float64(total)})
+	}
+	sort.Slice(scoreList, func(i, j int) bool {
+		if scoreList[i].Score != scoreList[j].Score {
+			return scoreList[i].Score < scoreList[j].Score
+		}
+		ti, tj := totalCount[scoreList[i].Topic], totalCount[scoreList[j].Topic]
+		if ti != tj {
+			return ti > tj
+		}
+		return scoreList[i].Topic < scoreList[j].Topic
+	})
+
+	weak := []string{}
+	for i := 0; i < min(3, len(scoreList)); i++ {
+		weak = append(weak, scoreList[i].Topic)
+	}
+	return weak
+}
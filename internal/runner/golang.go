@@ -0,0 +1,95 @@
+package runner
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// goTestEvent is one line of `go test -json` output.
+type goTestEvent struct {
+	Action  string
+	Test    string
+	Elapsed float64 // seconds
+	Output  string
+}
+
+func runGo(ctx context.Context, dir string) (Result, error) {
+	if _, err := exec.LookPath("go"); err != nil {
+		return Result{}, ErrNoRunner
+	}
+
+	cmd := command(ctx, dir, "go", "test", "-json", "-count=1", ".")
+	out, _ := cmd.CombinedOutput() // non-zero exit just means failing tests
+	if ctx.Err() != nil {
+		return Result{}, ctx.Err()
+	}
+	return parseGoTest(out), nil
+}
+
+// tableTest is the table-driven test the scaffold generates.
+const tableTest = "TestSolution"
+
+// parseGoTest turns `go test -json` output into a Result.
+func parseGoTest(out []byte) Result {
+	res := Result{}
+	var text strings.Builder
+	results := map[string]CaseResult{}
+	order := []string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		var ev goTestEvent
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			// build errors are printed as plain text
+			text.WriteString(scanner.Text() + "\n")
+			continue
+		}
+		text.WriteString(ev.Output)
+
+		if ev.Test == "" {
+			continue
+		}
+		switch ev.Action {
+		case "pass", "fail", "skip":
+			if _, seen := results[ev.Test]; !seen {
+				order = append(order, ev.Test)
+			}
+			results[ev.Test] = CaseResult{
+				Name:    ev.Test,
+				Passed:  ev.Action == "pass",
+				Skipped: ev.Action == "skip",
+				Elapsed: time.Duration(ev.Elapsed * float64(time.Second)),
+			}
+		}
+	}
+
+	// report leaf tests only: a parent test is covered by its subtests, and
+	// a passing scaffold table test without any subtests (an empty table)
+	// tested nothing
+	for _, name := range order {
+		if hasSubtests(name, order) {
+			continue
+		}
+		if name == tableTest && results[name].Passed {
+			continue
+		}
+		res.Cases = append(res.Cases, results[name])
+	}
+
+	res.Output = text.String()
+	return res
+}
+
+func hasSubtests(name string, all []string) bool {
+	for _, other := range all {
+		if strings.HasPrefix(other, name+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package runner
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseGoTest(t *testing.T) {
+	out := strings.Join([]string{
+		`{"Action":"run","Test":"TestPlain"}`,
+		`{"Action":"pass","Test":"TestPlain","Elapsed":0.01}`,
+		`{"Action":"run","Test":"TestTable"}`,
+		`{"Action":"run","Test":"TestTable/example_1"}`,
+		`{"Action":"pass","Test":"TestTable/example_1","Elapsed":0}`,
+		`{"Action":"run","Test":"TestTable/example_2"}`,
+		`{"Action":"fail","Test":"TestTable/example_2","Elapsed":0}`,
+		`{"Action":"fail","Test":"TestTable","Elapsed":0}`,
+		`{"Action":"run","Test":"TestSolution"}`,
+		`{"Action":"pass","Test":"TestSolution","Elapsed":0}`,
+		`{"Action":"fail","Elapsed":0.02}`,
+	}, "\n")
+
+	res := parseGoTest([]byte(out))
+	got := []string{}
+	for _, c := range res.Cases {
+		got = append(got, c.Name)
+	}
+	want := []string{"TestPlain", "TestTable/example_1", "TestTable/example_2"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("cases = %v, want %v", got, want)
+	}
+	if res.Ran() != 3 || res.Failed() != 1 {
+		t.Errorf("ran %d, failed %d; want 3, 1", res.Ran(), res.Failed())
+	}
+}
+
+func TestParseGoTestEmptyTable(t *testing.T) {
+	out := `{"Action":"run","Test":"TestSolution"}
+{"Action":"pass","Test":"TestSolution","Elapsed":0}
+{"Action":"pass","Elapsed":0.01}`
+
+	res := parseGoTest([]byte(out))
+	if res.Ran() != 0 || res.Passed() {
+		t.Errorf("empty table: ran %d, passed %v; want 0, false", res.Ran(), res.Passed())
+	}
+}
+
+func TestParseGoTestPlainPass(t *testing.T) {
+	out := `{"Action":"run","Test":"TestPlain"}
+{"Action":"pass","Test":"TestPlain","Elapsed":0}`
+
+	if res := parseGoTest([]byte(out)); !res.Passed() {
+		t.Errorf("plain passing test: Passed() = false, cases %v", res.Cases)
+	}
+}
@@ -0,0 +1,16 @@
+//go:build !unix
+
+package runner
+
+import (
+	"context"
+	"os/exec"
+)
+
+// command is exec.CommandContext; without process groups only the direct
+// child is killed on cancellation.
+func command(ctx context.Context, dir, name string, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	return cmd
+}
@@ -0,0 +1,22 @@
+//go:build unix
+
+package runner
+
+import (
+	"context"
+	"os/exec"
+	"syscall"
+)
+
+// command is exec.CommandContext that, on cancellation, kills the whole
+// process group: go test runs the test binary as a child of its own, which
+// would otherwise keep spinning in an endless loop.
+func command(ctx context.Context, dir, name string, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	return cmd
+}
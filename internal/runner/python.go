@@ -0,0 +1,66 @@
+package runner
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+var (
+	// test_solution.py::test_solution[example 1] PASSED     [ 50%]
+	pytestResult = regexp.MustCompile(`^(\S+::\S.*?) (PASSED|FAILED|ERROR|SKIPPED)`)
+	// 0.01s call     test_solution.py::test_solution[example 1]
+	pytestDuration = regexp.MustCompile(`^([\d.]+)s call\s+(\S.*)$`)
+)
+
+func runPytest(ctx context.Context, dir string) (Result, error) {
+	if _, err := exec.LookPath("pytest"); err != nil {
+		return Result{}, ErrNoRunner
+	}
+
+	cmd := command(ctx, dir, "pytest", "-v", "--durations=0", "--durations-min=0", "-p", "no:cacheprovider")
+	out, _ := cmd.CombinedOutput()
+	if ctx.Err() != nil {
+		return Result{}, ctx.Err()
+	}
+
+	res := Result{Output: string(out)}
+	index := map[string]int{}
+	durations := map[string]time.Duration{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if m := pytestResult.FindStringSubmatch(line); m != nil {
+			if _, seen := index[m[1]]; seen {
+				continue
+			}
+			index[m[1]] = len(res.Cases)
+			res.Cases = append(res.Cases, CaseResult{
+				Name:    m[1],
+				Passed:  m[2] == "PASSED",
+				Skipped: m[2] == "SKIPPED",
+			})
+			continue
+		}
+
+		if m := pytestDuration.FindStringSubmatch(line); m != nil {
+			if secs, err := strconv.ParseFloat(m[1], 64); err == nil {
+				durations[m[2]] = time.Duration(secs * float64(time.Second))
+			}
+		}
+	}
+
+	for name, d := range durations {
+		if i, ok := index[name]; ok {
+			res.Cases[i].Elapsed = d
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,90 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// CaseResult is the outcome of a single test case.
+type CaseResult struct {
+	Name    string
+	Passed  bool
+	Skipped bool
+	Elapsed time.Duration
+}
+
+// Result is the outcome of running a workspace's tests.
+type Result struct {
+	Lang    string
+	Cases   []CaseResult
+	Elapsed time.Duration
+	Output  string // raw tool output, useful when nothing could be parsed
+}
+
+// Passed reports whether at least one case ran and none failed.
+func (r Result) Passed() bool {
+	return r.Ran() > 0 && r.Failed() == 0
+}
+
+// Ran counts cases that actually ran, i.e. were not skipped.
+func (r Result) Ran() int {
+	n := 0
+	for _, c := range r.Cases {
+		if !c.Skipped {
+			n++
+		}
+	}
+	return n
+}
+
+// Failed counts failing cases.
+func (r Result) Failed() int {
+	n := 0
+	for _, c := range r.Cases {
+		if !c.Passed && !c.Skipped {
+			n++
+		}
+	}
+	return n
+}
+
+// ErrNoRunner is returned when the tool needed for a language is missing.
+var ErrNoRunner = errors.New("test runner not installed")
+
+// DetectLang guesses the workspace language from its solution file,
+// preferring Go when both were scaffolded.
+func DetectLang(dir string) (string, error) {
+	if _, err := os.Stat(filepath.Join(dir, "solution.go")); err == nil {
+		return "go", nil
+	}
+	if _, err := os.Stat(filepath.Join(dir, "solution.py")); err == nil {
+		return "python", nil
+	}
+	return "", fmt.Errorf("no solution.go or solution.py in %s", dir)
+}
+
+// Run executes the tests of the workspace in dir.
+func Run(ctx context.Context, dir, lang string) (Result, error) {
+	start := time.Now()
+
+	var (
+		res Result
+		err error
+	)
+	switch lang {
+	case "go":
+		res, err = runGo(ctx, dir)
+	case "python":
+		res, err = runPytest(ctx, dir)
+	default:
+		return Result{}, fmt.Errorf("unsupported language %q", lang)
+	}
+
+	res.Lang = lang
+	res.Elapsed = time.Since(start)
+	return res, err
+}
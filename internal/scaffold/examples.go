@@ -0,0 +1,126 @@
+package scaffold
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+// Arg is one named argument of an example, with its value as JSON.
+type Arg struct {
+	Name  string
+	Value string
+}
+
+// Case is a test case derived from a problem example.
+type Case struct {
+	Name string
+	Args []Arg
+	Want string // expected output as JSON
+	Raw  string // original input text, kept for reference
+	OK   bool   // false when the input could not be parsed into Args
+}
+
+// ArgsJSON renders the arguments as one JSON object.
+func (c Case) ArgsJSON() string {
+	parts := make([]string, 0, len(c.Args))
+	for _, a := range c.Args {
+		parts = append(parts, strconv.Quote(a.Name)+": "+a.Value)
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+type templateData struct {
+	Problem  data.Problem
+	Package  string
+	Link     string
+	Cases    []Case
+	ArgNames []string
+}
+
+func newTemplateData(p data.Problem) templateData {
+	td := templateData{
+		Problem: p,
+		Package: "p" + p.ID,
+		Link:    p.Link(),
+	}
+
+	seen := map[string]bool{}
+	for i, ex := range p.Examples {
+		c := parseExample(ex)
+		c.Name = fmt.Sprintf("example %d", i+1)
+		td.Cases = append(td.Cases, c)
+		for _, a := range c.Args {
+			if !seen[a.Name] {
+				seen[a.Name] = true
+				td.ArgNames = append(td.ArgNames, a.Name)
+			}
+		}
+	}
+
+	return td
+}
+
+// parseExample turns `nums = [2,7,11,15], target = 9` into named JSON args.
+func parseExample(ex data.Example) Case {
+	c := Case{Raw: ex.Input, Want: strings.TrimSpace(ex.Output)}
+	if !json.Valid([]byte(c.Want)) {
+		c.Want = strconv.Quote(c.Want)
+	}
+
+	for _, part := range splitTopLevel(ex.Input) {
+		name, value, found := strings.Cut(part, "=")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if !found || !isIdent(name) || !json.Valid([]byte(value)) {
+			c.Args = nil
+			return c
+		}
+		c.Args = append(c.Args, Arg{Name: name, Value: value})
+	}
+
+	c.OK = len(c.Args) > 0
+	return c
+}
+
+// splitTopLevel splits on commas that are not inside brackets or quotes.
+func splitTopLevel(s string) []string {
+	parts := []string{}
+	depth, start := 0, 0
+	inString := false
+
+	for i, r := range s {
+		switch {
+		case r == '"' && (i == 0 || s[i-1] != '\\'):
+			inString = !inString
+		case inString:
+		case r == '[' || r == '{':
+			depth++
+		case r == ']' || r == '}':
+			depth--
+		case r == ',' && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+
+	if strings.TrimSpace(s[start:]) != "" {
+		parts = append(parts, s[start:])
+	}
+	return parts
+}
+
+func isIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if r != '_' && !unicode.IsLetter(r) && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
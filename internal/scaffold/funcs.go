@@ -0,0 +1,19 @@
+package scaffold
+
+import (
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+var funcs = template.FuncMap{
+	"quote": strconv.Quote,
+	"join":  strings.Join,
+	// backquote wraps s in a Go raw string literal when possible.
+	"backquote": func(s string) string {
+		if strings.Contains(s, "`") {
+			return strconv.Quote(s)
+		}
+		return "`" + s + "`"
+	},
+}
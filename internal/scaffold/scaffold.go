@@ -0,0 +1,173 @@
+package scaffold
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+//go:embed templates
+var defaultTemplates embed.FS
+
+// DefaultDir is where workspaces are created, relative to the working directory.
+const DefaultDir = "workspace"
+
+// Languages lists the languages that have built-in templates.
+func Languages() []string {
+	entries, _ := defaultTemplates.ReadDir("templates")
+	langs := []string{}
+	for _, e := range entries {
+		if e.IsDir() {
+			langs = append(langs, e.Name())
+		}
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// Options controls where and how a workspace is generated.
+type Options struct {
+	Lang         string // go, python
+	Dir          string // parent directory of all workspaces
+	TemplatesDir string // per-user overrides, e.g. data/templates
+	Force        bool   // overwrite existing files
+}
+
+// WorkspaceDir returns the directory used for a problem, e.g. workspace/1-two-sum.
+func WorkspaceDir(dir string, p data.Problem) string {
+	return filepath.Join(dir, p.ID+"-"+p.TitleSlug)
+}
+
+// FindWorkspace locates an existing workspace for a problem ID.
+func FindWorkspace(dir, id string) (string, bool) {
+	matches, _ := filepath.Glob(filepath.Join(dir, id+"-*"))
+	for _, m := range matches {
+		if info, err := os.Stat(m); err == nil && info.IsDir() {
+			return m, true
+		}
+	}
+	return "", false
+}
+
+// metaFile holds workspace bookkeeping that must survive edits to the
+// generated files and scaffold --force.
+const metaFile = ".goleet.json"
+
+type meta struct {
+	ID           string    `json:"id"`
+	ScaffoldedAt time.Time `json:"scaffoldedAt"`
+}
+
+// StartedAt is when the workspace was first scaffolded.
+func StartedAt(dir string) (time.Time, bool) {
+	raw, err := os.ReadFile(filepath.Join(dir, metaFile))
+	if err != nil {
+		return time.Time{}, false
+	}
+	var m meta
+	if err := json.Unmarshal(raw, &m); err != nil || m.ScaffoldedAt.IsZero() {
+		return time.Time{}, false
+	}
+	return m.ScaffoldedAt, true
+}
+
+// writeMeta records the scaffold time unless the workspace already has one.
+func writeMeta(dir string, p data.Problem) error {
+	path := filepath.Join(dir, metaFile)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	raw, err := json.MarshalIndent(meta{ID: p.ID, ScaffoldedAt: time.Now()}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, raw, 0644)
+}
+
+// Generate renders every template of opts.Lang into the problem's workspace
+// and returns the paths of the files it wrote.
+func Generate(p data.Problem, opts Options) ([]string, error) {
+	root := path.Join("templates", opts.Lang)
+	if _, err := fs.Stat(defaultTemplates, root); err != nil {
+		return nil, fmt.Errorf("unsupported language %q (available: %s)",
+			opts.Lang, strings.Join(Languages(), ", "))
+	}
+
+	dir := WorkspaceDir(opts.Dir, p)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	td := newTemplateData(p)
+	written := []string{}
+
+	err := fs.WalkDir(defaultTemplates, root, func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(name, ".tmpl") {
+			return err
+		}
+
+		rel := strings.TrimPrefix(name, root+"/")
+		src, err := readTemplate(opts, name, path.Join(opts.Lang, rel))
+		if err != nil {
+			return err
+		}
+
+		out := filepath.Join(dir, strings.TrimSuffix(rel, ".tmpl"))
+		if _, err := os.Stat(out); err == nil && !opts.Force {
+			return nil // never clobber user work
+		}
+
+		tmpl, err := template.New(rel).Funcs(funcs).Parse(src)
+		if err != nil {
+			return fmt.Errorf("template %s: %w", rel, err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, td); err != nil {
+			return fmt.Errorf("template %s: %w", rel, err)
+		}
+
+		content := buf.Bytes()
+		if strings.HasSuffix(out, ".go") {
+			// keep generated Go gofmt-clean; fall back to raw output on error
+			if formatted, err := format.Source(content); err == nil {
+				content = formatted
+			}
+		}
+
+		if err := os.WriteFile(out, content, 0644); err != nil {
+			return err
+		}
+		written = append(written, out)
+		return nil
+	})
+	if err == nil && len(written) > 0 {
+		err = writeMeta(dir, p)
+	}
+
+	return written, err
+}
+
+// readTemplate prefers a user override (TemplatesDir/<lang>/<file>) over
+// the embedded default.
+func readTemplate(opts Options, embedded, override string) (string, error) {
+	if opts.TemplatesDir != "" {
+		b, err := os.ReadFile(filepath.Join(opts.TemplatesDir, filepath.FromSlash(override)))
+		if err == nil {
+			return string(b), nil
+		}
+	}
+	b, err := defaultTemplates.ReadFile(embedded)
+	return string(b), err
+}
@@ -0,0 +1,174 @@
+// Package similar finds related problems offline with a TF-IDF model over
+// topic tags, titles and, when the catalog has them, problem statements.
+package similar
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+// Field weights: tags say the most about a problem, statements the least
+// per word (and they are long).
+const (
+	topicWeight   = 2.0
+	titleWeight   = 1.0
+	contentWeight = 0.5
+
+	// listedBoost is added when leetcode.com itself lists the problem as similar.
+	listedBoost = 0.25
+)
+
+// Match is a related problem and how close it is (0..1+).
+type Match struct {
+	ID    string
+	Score float64
+}
+
+// Index holds a normalized TF-IDF vector per problem plus an inverted index
+// for fast queries.
+type Index struct {
+	catalog  *data.Catalog
+	vectors  map[string]map[string]float64 // problem ID -> term -> weight
+	postings map[string][]posting          // term -> problems containing it
+}
+
+type posting struct {
+	ID     string
+	Weight float64
+}
+
+// Build computes the index for every problem of the catalog.
+func Build(catalog *data.Catalog) *Index {
+	idx := &Index{
+		catalog:  catalog,
+		vectors:  map[string]map[string]float64{},
+		postings: map[string][]posting{},
+	}
+
+	// raw term frequencies and document frequencies
+	df := map[string]int{}
+	for _, p := range catalog.Problems {
+		tf := terms(p)
+		idx.vectors[p.ID] = tf
+		for t := range tf {
+			df[t]++
+		}
+	}
+
+	n := float64(len(catalog.Problems))
+	for _, p := range catalog.Problems {
+		vec := idx.vectors[p.ID]
+		norm := 0.0
+		for t, f := range vec {
+			w := (1 + math.Log(f)) * math.Log(1+n/float64(df[t]))
+			vec[t] = w
+			norm += w * w
+		}
+		if norm == 0 {
+			continue // nothing to compare by
+		}
+		norm = math.Sqrt(norm)
+		for t := range vec {
+			vec[t] /= norm
+			idx.postings[t] = append(idx.postings[t], posting{p.ID, vec[t]})
+		}
+	}
+	return idx
+}
+
+// Similar returns up to n problems closest to id, best first.
+func (idx *Index) Similar(id string, n int) []Match {
+	p, ok := idx.catalog.ByID(id)
+	if !ok {
+		return nil
+	}
+
+	scores := map[string]float64{}
+	for t, w := range idx.vectors[id] {
+		for _, post := range idx.postings[t] {
+			if post.ID != id {
+				scores[post.ID] += w * post.Weight
+			}
+		}
+	}
+	for _, sq := range p.SimilarQuestions {
+		if q, ok := idx.catalog.BySlug(sq.TitleSlug); ok && q.ID != id {
+			scores[q.ID] += listedBoost
+		}
+	}
+
+	matches := make([]Match, 0, len(scores))
+	for qid, s := range scores {
+		matches = append(matches, Match{ID: qid, Score: s})
+	}
+	sortMatches(matches)
+	if len(matches) > n {
+		matches = matches[:n]
+	}
+	return matches
+}
+
+// sortMatches orders by score, then numerically by ID for stable output.
+func sortMatches(m []Match) {
+	sort.Slice(m, func(i, j int) bool {
+		if m[i].Score != m[j].Score {
+			return m[i].Score > m[j].Score
+		}
+		a, errA := strconv.Atoi(m[i].ID)
+		b, errB := strconv.Atoi(m[j].ID)
+		if errA == nil && errB == nil {
+			return a < b
+		}
+		return m[i].ID < m[j].ID
+	})
+}
+
+var (
+	htmlTag  = regexp.MustCompile(`<[^>]*>`)
+	nonWord  = regexp.MustCompile(`[^a-z0-9]+`)
+	stopword = map[string]bool{
+		"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+		"be": true, "by": true, "can": true, "for": true, "from": true, "given": true,
+		"if": true, "in": true, "is": true, "it": true, "its": true, "of": true,
+		"on": true, "or": true, "return": true, "that": true, "the": true, "this": true,
+		"to": true, "with": true, "you": true, "your": true,
+	}
+)
+
+// terms returns the weighted term frequencies of a problem. Topic tags are
+// kept whole ("t:dynamic programming") so they never mix with title words.
+func terms(p data.Problem) map[string]float64 {
+	tf := map[string]float64{}
+	for _, t := range p.TopicTags {
+		tf["t:"+strings.ToLower(t.Name)] += topicWeight
+	}
+	for _, w := range words(p.Title) {
+		tf[w] += titleWeight
+	}
+	if p.Content != "" {
+		for _, w := range words(htmlTag.ReplaceAllString(p.Content, " ")) {
+			tf[w] += contentWeight
+		}
+	}
+	return tf
+}
+
+// words lower-cases, splits and lightly stems text.
+func words(s string) []string {
+	out := []string{}
+	for _, w := range nonWord.Split(strings.ToLower(s), -1) {
+		if len(w) < 2 || stopword[w] {
+			continue
+		}
+		if len(w) > 3 && strings.HasSuffix(w, "s") && !strings.HasSuffix(w, "ss") {
+			w = w[:len(w)-1] // "numbers" -> "number"
+		}
+		out = append(out, w)
+	}
+	return out
+}
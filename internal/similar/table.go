@@ -0,0 +1,110 @@
+package similar
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	assets "github.com/chhand2808/goleet/data"
+	"github.com/chhand2808/goleet/internal/data"
+)
+
+// TableSize is how many neighbors are precomputed per problem.
+const TableSize = 10
+
+// tableFormat is bumped whenever the gob layout or the model changes.
+const tableFormat = 1
+
+// Table is the precomputed neighbor list of every problem, tied to the
+// catalog it was built from by Fingerprint.
+type Table struct {
+	Format      int
+	Fingerprint string
+	Neighbors   map[string][]Match
+}
+
+// Precompute builds the neighbor table for the whole catalog.
+func Precompute(catalog *data.Catalog, idx *Index) Table {
+	t := Table{
+		Format:      tableFormat,
+		Fingerprint: Fingerprint(catalog),
+		Neighbors:   make(map[string][]Match, catalog.Len()),
+	}
+	for _, p := range catalog.Problems {
+		t.Neighbors[p.ID] = idx.Similar(p.ID, TableSize)
+	}
+	return t
+}
+
+// Fingerprint hashes everything the model reads, so a table is only used
+// with the catalog it was computed for.
+func Fingerprint(catalog *data.Catalog) string {
+	h := sha256.New()
+	for _, p := range catalog.Problems {
+		fmt.Fprintf(h, "%s\x00%s\x00%s\x00%s\x00", p.ID, p.Title, strings.Join(p.Topics(), ","), p.Content)
+		for _, sq := range p.SimilarQuestions {
+			fmt.Fprintf(h, "%s,", sq.TitleSlug)
+		}
+		h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// EncodeTable writes t as gob.
+func EncodeTable(w io.Writer, t Table) error {
+	return gob.NewEncoder(w).Encode(t)
+}
+
+// DecodeTable reads a table written by EncodeTable.
+func DecodeTable(r io.Reader) (Table, error) {
+	var t Table
+	if err := gob.NewDecoder(r).Decode(&t); err != nil {
+		return t, err
+	}
+	if t.Format != tableFormat {
+		return t, fmt.Errorf("similarity table format %d, want %d", t.Format, tableFormat)
+	}
+	return t, nil
+}
+
+// Model answers similarity queries from the precomputed table when it
+// matches the catalog, and from a freshly built index otherwise.
+type Model struct {
+	catalog *data.Catalog
+	table   map[string][]Match
+	index   *Index
+}
+
+// ForCatalog returns the similarity model for catalog, using the table
+// embedded at build time when it fits.
+func ForCatalog(catalog *data.Catalog) *Model {
+	m := &Model{catalog: catalog}
+	if t, err := DecodeTable(bytes.NewReader(assets.EmbeddedSimilar)); err == nil && t.Fingerprint == Fingerprint(catalog) {
+		m.table = t.Neighbors
+	}
+	return m
+}
+
+// Similar returns up to n problems closest to id, best first.
+func (m *Model) Similar(id string, n int) []Match {
+	if m.table != nil && n <= TableSize {
+		matches := m.table[id]
+		if len(matches) > n {
+			matches = matches[:n]
+		}
+		return matches
+	}
+	if m.index == nil {
+		m.index = Build(m.catalog)
+	}
+	return m.index.Similar(id, n)
+}
+
+// Precomputed reports whether answers come from the build-time table.
+func (m *Model) Precomputed() bool {
+	return m.table != nil
+}
@@ -5,23 +5,23 @@ import (
 	"time"
 )
 
-var spinnerRunning = false
-
+// StartSpinner animates a "thinking" line until StopSpinner is called.
 func StartSpinner() chan bool {
 	stop := make(chan bool)
-	spinnerRunning = true
 
 	go func() {
 		frames := []string{"🤖 thinking.", "🤖 thinking..", "🤖 thinking..."}
-		i := 0
-		for spinnerRunning {
+		ticker := time.NewTicker(300 * time.Millisecond)
+		defer ticker.Stop()
+
+		for i := 0; ; i++ {
 			fmt.Printf("\r%s", frames[i%len(frames)])
-			time.Sleep(300 * time.Millisecond)
-			i++
 			select {
 			case <-stop:
+				fmt.Print("\r\033[K") // clear spinner line
+				stop <- true          // acknowledge, so callers print after the clear
 				return
-			default:
+			case <-ticker.C:
 			}
 		}
 	}()
@@ -29,8 +29,12 @@ func StartSpinner() chan bool {
 	return stop
 }
 
+// StopSpinner stops the spinner and waits until its line is cleared.
+// It is safe to call with a nil channel.
 func StopSpinner(stop chan bool) {
-	spinnerRunning = false
+	if stop == nil {
+		return
+	}
 	stop <- true
-	fmt.Print("\r") // clear spinner line
+	<-stop
 }